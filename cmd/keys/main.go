@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"go-test/lib/logger"
+	"go-test/lib/provider"
+	"go-test/src/app"
+)
+
+// The keys command manages the JWT keyring and only needs the config, so it
+// can run before the database is up. Rotating without downtime takes two
+// steps: generate a pending key, wait until every instance and JWKS client
+// knows it, then rotate.
+func main() {
+	ctx := context.Background()
+
+	logger.Init(ctx)
+
+	cfg, err := app.InitConfig(ctx)
+	if err != nil {
+		logger.GetLogger(ctx).Fatalf("Failed to load config: %v", err)
+	}
+
+	args := os.Args
+	if len(args) < 2 {
+		logger.GetLogger(ctx).Fatal("Missing args. args: [generate | rotate | list]")
+	}
+
+	ring, err := provider.LoadKeyRing(cfg.JWT.KeysDir)
+	if err != nil {
+		logger.GetLogger(ctx).Fatalf("Failed to load keyring: %v", err)
+	}
+
+	switch args[1] {
+	case "generate":
+		info, err := ring.Generate(time.Now())
+		if err != nil {
+			logger.GetLogger(ctx).Fatalf("Failed to generate key: %v", err)
+		}
+		if err := ring.Save(); err != nil {
+			logger.GetLogger(ctx).Fatalf("Failed to save keyring: %v", err)
+		}
+		logger.GetLogger(ctx).Infof("Key %s generated as %s", info.Kid, info.Status)
+	case "rotate":
+		// The old key keeps verifying the tokens it signed until they expire.
+		// Instances that have not reloaded the keyring yet keep signing with
+		// it for up to one reload interval.
+		info, err := ring.Rotate(time.Now(), cfg.Auth.AccessTokenTTL+cfg.JWT.KeysReloadInterval)
+		if err != nil {
+			logger.GetLogger(ctx).Fatalf("Failed to rotate key: %v", err)
+		}
+		if err := ring.Save(); err != nil {
+			logger.GetLogger(ctx).Fatalf("Failed to save keyring: %v", err)
+		}
+		logger.GetLogger(ctx).Infof("Key %s is now active", info.Kid)
+	case "list":
+		for _, info := range ring.Keys() {
+			expiresAt := "-"
+			if info.ExpiresAt != nil {
+				expiresAt = info.ExpiresAt.Format("2006-01-02 15:04:05")
+			}
+			logger.GetLogger(ctx).Infof("%s  %-7s  created %s  expires %s", info.Kid, info.Status, info.CreatedAt.Format("2006-01-02 15:04:05"), expiresAt)
+		}
+	default:
+		logger.GetLogger(ctx).Fatal("Invalid keys command. Use: [generate | rotate | list]")
+	}
+}
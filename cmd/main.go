@@ -18,6 +18,7 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/gin-gonic/gin"
 	"go-test/lib/logger"
@@ -27,10 +28,6 @@ import (
 )
 
 func main() {
-	if err := os.Setenv("TZ", "Asia/Jakarta"); err != nil {
-		panic(err)
-	}
-
 	initCtx := context.Background()
 	logger.Init(initCtx)
 
@@ -38,6 +35,12 @@ func main() {
 		panic(err)
 	}
 
+	loc, err := time.LoadLocation(app.Config().DefaultTimezone)
+	if err != nil {
+		panic(err)
+	}
+	time.Local = loc
+
 	startService(initCtx)
 }
 
@@ -55,6 +58,9 @@ func startService(ctx context.Context) {
 	}())
 
 	r := gin.New()
+	if err := r.SetTrustedProxies(app.Config().TrustedProxies); err != nil {
+		logger.GetLogger(ctx).Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
 	r.Use(gin.Recovery())
 	r.Use(gin.Logger())
 	r.Use(ginmiddleware.RequestIDMiddleware())
@@ -89,5 +95,10 @@ func startService(ctx context.Context) {
 		logger.GetLogger(ctx).Errorf("Server forced to shutdown: %v", err)
 	}
 
+	// Requests are done, but the mails they queued may still be sending.
+	if err := deps.Services.AccountService.Wait(shutdownCtx); err != nil {
+		logger.GetLogger(ctx).Errorf("Mails still sending at shutdown: %v", err)
+	}
+
 	logger.GetLogger(ctx).Info("Server exited gracefully")
 }
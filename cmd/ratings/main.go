@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"go-test/lib/logger"
+	"go-test/src/app"
+	v1 "go-test/src/v1"
+)
+
+func main() {
+	ctx := context.Background()
+
+	logger.Init(ctx)
+
+	if err := app.Init(ctx); err != nil {
+		logger.GetLogger(ctx).Fatalf("Failed to initialize app: %v", err)
+	}
+	defer app.Close()
+
+	args := os.Args
+	if len(args) < 2 {
+		logger.GetLogger(ctx).Fatal("Missing args. args: [recompute]")
+	}
+
+	deps := v1.Dependencies(ctx)
+
+	switch args[1] {
+	case "recompute":
+		rated, err := deps.Services.RatingService.RecomputeAll(ctx)
+		if err != nil {
+			logger.GetLogger(ctx).Fatalf("Failed to recompute ratings: %v", err)
+		}
+		logger.GetLogger(ctx).Infof("Rating recompute completed successfully, %d matches rated", rated)
+	default:
+		logger.GetLogger(ctx).Fatal("Invalid ratings command. Use: [recompute]")
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"go-test/lib/logger"
+	"go-test/src/app"
+	"go-test/src/entity"
+	v1 "go-test/src/v1"
+)
+
+func main() {
+	ctx := context.Background()
+
+	logger.Init(ctx)
+
+	if err := app.Init(ctx); err != nil {
+		logger.GetLogger(ctx).Fatalf("Failed to initialize app: %v", err)
+	}
+	defer app.Close()
+
+	args := os.Args
+	if len(args) < 2 {
+		logger.GetLogger(ctx).Fatal("Missing args. args: [set-role <email> <role> | grant-team <email> <team_id> | revoke-team <email> <team_id>]")
+	}
+
+	deps := v1.Dependencies(ctx)
+
+	switch args[1] {
+	case "set-role":
+		if len(args) < 4 {
+			logger.GetLogger(ctx).Fatal("Missing args. args: set-role <email> <role>")
+		}
+		if err := deps.Services.UserService.SetRoleByEmail(ctx, args[2], entity.UserRole(args[3])); err != nil {
+			logger.GetLogger(ctx).Fatalf("Failed to set role: %v", err)
+		}
+		logger.GetLogger(ctx).Infof("Role of %s set to %s", args[2], args[3])
+	case "grant-team", "revoke-team":
+		if len(args) < 4 {
+			logger.GetLogger(ctx).Fatalf("Missing args. args: %s <email> <team_id>", args[1])
+		}
+		teamID, err := strconv.ParseInt(args[3], 10, 64)
+		if err != nil {
+			logger.GetLogger(ctx).Fatalf("Invalid team id: %s", args[3])
+		}
+		if args[1] == "grant-team" {
+			err = deps.Services.UserService.GrantTeam(ctx, args[2], teamID)
+		} else {
+			err = deps.Services.UserService.RevokeTeam(ctx, args[2], teamID)
+		}
+		if err != nil {
+			logger.GetLogger(ctx).Fatalf("Failed to %s: %v", args[1], err)
+		}
+		logger.GetLogger(ctx).Infof("%s team %d for %s completed successfully", args[1], teamID, args[2])
+	default:
+		logger.GetLogger(ctx).Fatal("Invalid users command. Use: [set-role <email> <role> | grant-team <email> <team_id> | revoke-team <email> <team_id>]")
+	}
+}
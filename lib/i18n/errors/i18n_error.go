@@ -19,3 +19,30 @@ func NewI18nError(key string) I18nError {
 func (e *i18nError) Error() string {
 	return e.key
 }
+
+// i18nDetailedError is an I18nError that also carries structured details
+// (e.g. a list of conflicts) to be rendered alongside the localized message.
+type i18nDetailedError struct {
+	I18nError
+	details interface{}
+}
+
+// WithDetails attaches details to err. errors.Is(result, err) still holds.
+func WithDetails(err I18nError, details interface{}) I18nError {
+	return &i18nDetailedError{
+		I18nError: err,
+		details:   details,
+	}
+}
+
+func (e *i18nDetailedError) Unwrap() error {
+	return e.I18nError
+}
+
+// Details returns the details attached with WithDetails, or nil.
+func Details(err error) interface{} {
+	if e, ok := err.(*i18nDetailedError); ok {
+		return e.details
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Package ical writes RFC 5545 iCalendar documents.
+package ical
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+
+	dateTimeUTC = "20060102T150405Z"
+	maxLineLen  = 75
+)
+
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []Event
+}
+
+type Event struct {
+	UID          string
+	Sequence     int
+	Stamp        time.Time
+	LastModified time.Time
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Location     string
+	Status       string
+}
+
+// Marshal renders the calendar with CRLF line endings and folded lines.
+func (c Calendar) Marshal() []byte {
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+escapeText(c.ProdID))
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+
+	for _, e := range c.Events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+e.UID)
+		writeLine(&b, "SEQUENCE:"+strconv.Itoa(e.Sequence))
+		writeLine(&b, "DTSTAMP:"+formatUTC(e.Stamp))
+		if !e.LastModified.IsZero() {
+			writeLine(&b, "LAST-MODIFIED:"+formatUTC(e.LastModified))
+		}
+		writeLine(&b, "DTSTART:"+formatUTC(e.Start))
+		writeLine(&b, "DTEND:"+formatUTC(e.End))
+		writeLine(&b, "SUMMARY:"+escapeText(e.Summary))
+		if e.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(e.Description))
+		}
+		if e.Location != "" {
+			writeLine(&b, "LOCATION:"+escapeText(e.Location))
+		}
+		if e.Status != "" {
+			writeLine(&b, "STATUS:"+e.Status)
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format(dateTimeUTC)
+}
+
+// escapeText escapes a TEXT value. CRLF, LF and a lone CR all become an
+// escaped newline, as a raw CR would break the content line.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeLine folds content lines longer than 75 octets, without splitting a
+// UTF-8 sequence, by continuing on a new line that starts with a space.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineLen
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineLen - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go-test/lib/logger"
+)
+
+// FileMailer is for local development and tests: each message is written to
+// dir as an .eml file and logged. With an empty dir it is only logged.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{dir: dir, from: from}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if m.dir == "" {
+		logger.GetLogger(ctx).Infof("mail to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102-150405.000000000"), strings.NewReplacer("@", "_at_", "/", "_").Replace(msg.To))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, encode(m.from, msg), 0o600); err != nil {
+		return err
+	}
+
+	logger.GetLogger(ctx).Infof("mail to %s saved to %s", msg.To, path)
+	return nil
+}
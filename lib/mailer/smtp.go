@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+)
+
+// SMTPMailer sends through an SMTP server, upgrading to TLS with STARTTLS
+// when the server offers it. Without a username no authentication is used.
+type SMTPMailer struct {
+	host     string
+	addr     string
+	username string
+	password string
+	// from is the From header, e.g. "Football API <no-reply@example.com>";
+	// envelopeFrom is its bare address.
+	from         string
+	envelopeFrom string
+}
+
+func NewSMTPMailer(host string, port int, username, password, from string) (*SMTPMailer, error) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPMailer{
+		host:         host,
+		addr:         net.JoinHostPort(host, strconv.Itoa(port)),
+		username:     username,
+		password:     password,
+		from:         address.String(),
+		envelopeFrom: address.Address,
+	}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+	return smtp.SendMail(m.addr, auth, m.envelopeFrom, []string{msg.To}, encode(m.from, msg))
+}
@@ -1,30 +1,69 @@
 package ginmiddleware
 
 import (
+	"context"
 	"crypto/rsa"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	i18n_err "go-test/lib/i18n/errors"
 	apperrors "go-test/src/errors"
 )
 
 const (
 	GinUserIDKey   = "user_id"
 	GinUserTypeKey = "user_type"
+	GinTeamIDsKey  = "team_ids"
+	GinSessionKey  = "session_id"
+	GinAPIKeyIDKey = "api_key_id"
+	GinScopesKey   = "scopes"
+
+	APIKeyHeader = "X-API-Key"
 )
 
+// Authorizer reports whether a role is granted a permission.
+type Authorizer func(role, permission string) bool
+
+// KeyResolver returns the public key with the given kid, if it may still be
+// used to verify tokens.
+type KeyResolver func(ctx context.Context, kid string) (*rsa.PublicKey, bool)
+
+// RevocationChecker reports whether the token with the given jti was revoked.
+type RevocationChecker func(ctx context.Context, jti string) bool
+
+// APIKeyAuthenticator resolves an API key sent from ip to the key's id and
+// the permissions it was granted.
+type APIKeyAuthenticator func(ctx context.Context, key, ip string) (id int64, scopes []string, err error)
+
 type GinJWTMiddleware struct {
-	publicKey *rsa.PublicKey
+	resolveKey         KeyResolver
+	authorizer         Authorizer
+	isRevoked          RevocationChecker
+	authenticateAPIKey APIKeyAuthenticator
 }
 
-func NewGinJWTMiddleware(publicKey *rsa.PublicKey) *GinJWTMiddleware {
-	return &GinJWTMiddleware{publicKey: publicKey}
+func NewGinJWTMiddleware(resolveKey KeyResolver, authorizer Authorizer, isRevoked RevocationChecker, authenticateAPIKey APIKeyAuthenticator) *GinJWTMiddleware {
+	return &GinJWTMiddleware{
+		resolveKey:         resolveKey,
+		authorizer:         authorizer,
+		isRevoked:          isRevoked,
+		authenticateAPIKey: authenticateAPIKey,
+	}
 }
 
+// Authenticate accepts a JWT in the Authorization header or, for machine
+// clients, an API key in the X-API-Key header. API key callers have scopes
+// instead of a user and role.
 func (m *GinJWTMiddleware) Authenticate() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" {
+			m.authenticateWithAPIKey(c, apiKey)
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUnauthorized.Error()})
@@ -45,7 +84,12 @@ func (m *GinJWTMiddleware) Authenticate() gin.HandlerFunc {
 			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 				return nil, apperrors.ErrInvalidToken
 			}
-			return m.publicKey, nil
+			kid, _ := token.Header["kid"].(string)
+			publicKey, ok := m.resolveKey(c.Request.Context(), kid)
+			if !ok {
+				return nil, apperrors.ErrInvalidToken
+			}
+			return publicKey, nil
 		})
 
 		if err != nil {
@@ -54,10 +98,21 @@ func (m *GinJWTMiddleware) Authenticate() gin.HandlerFunc {
 		}
 
 		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+			// Tokens without a jti can not be revoked and are not accepted.
+			jti, _ := claims["jti"].(string)
+			if jti == "" || m.isRevoked(c.Request.Context(), jti) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrInvalidToken.Error()})
+				return
+			}
+
 			userID := int64(claims["user_id"].(float64))
 			userType := claims["user_type"].(string)
 			c.Set(GinUserIDKey, userID)
 			c.Set(GinUserTypeKey, userType)
+			c.Set(GinTeamIDsKey, teamIDsFromClaims(claims))
+			if sid, ok := claims["sid"].(float64); ok {
+				c.Set(GinSessionKey, int64(sid))
+			}
 			c.Next()
 		} else {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrInvalidToken.Error()})
@@ -65,6 +120,25 @@ func (m *GinJWTMiddleware) Authenticate() gin.HandlerFunc {
 	}
 }
 
+func (m *GinJWTMiddleware) authenticateWithAPIKey(c *gin.Context, apiKey string) {
+	id, scopes, err := m.authenticateAPIKey(c.Request.Context(), apiKey, c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrAPIKeyIPNotAllowed):
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case errors.Is(err, apperrors.ErrInvalidAPIKey):
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		default:
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": i18n_err.ErrInternalServer.Error()})
+		}
+		return
+	}
+
+	c.Set(GinAPIKeyIDKey, id)
+	c.Set(GinScopesKey, scopes)
+	c.Next()
+}
+
 func (m *GinJWTMiddleware) RequireRole(role string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userType, exists := c.Get(GinUserTypeKey)
@@ -76,8 +150,28 @@ func (m *GinJWTMiddleware) RequireRole(role string) gin.HandlerFunc {
 	}
 }
 
-func (m *GinJWTMiddleware) RequireAdmin() gin.HandlerFunc {
-	return m.RequireRole("admin")
+// RequirePermission allows the request only when the caller's role, or the
+// scopes of its API key, grant permission. It must run after Authenticate.
+func (m *GinJWTMiddleware) RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !HasPermission(c, permission, m.authorizer) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": apperrors.ErrForbidden.Error()})
+			return
+		}
+		c.Next()
+	}
+}
+
+// HasPermission reports whether the caller's role, or the scopes of its API
+// key, grant permission. Handlers use it for permissions that depend on the
+// request, like a query flag.
+func HasPermission(c *gin.Context, permission string, authorizer Authorizer) bool {
+	if scopes, ok := c.Get(GinScopesKey); ok {
+		return containsScope(scopes.([]string), permission)
+	}
+
+	userType, exists := GetUserType(c)
+	return exists && authorizer(userType, permission)
 }
 
 func GetUserID(c *gin.Context) (int64, bool) {
@@ -97,3 +191,46 @@ func GetUserType(c *gin.Context) (string, bool) {
 	t, ok := v.(string)
 	return t, ok
 }
+
+// GetSessionID returns the session the access token was issued for.
+func GetSessionID(c *gin.Context) (int64, bool) {
+	v, exists := c.Get(GinSessionKey)
+	if !exists {
+		return 0, false
+	}
+	id, ok := v.(int64)
+	return id, ok
+}
+
+// GetTeamIDs returns the teams a team-scoped caller manages.
+func GetTeamIDs(c *gin.Context) []int64 {
+	v, exists := c.Get(GinTeamIDsKey)
+	if !exists {
+		return nil
+	}
+	ids, _ := v.([]int64)
+	return ids
+}
+
+func containsScope(scopes []string, permission string) bool {
+	for _, scope := range scopes {
+		if scope == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func teamIDsFromClaims(claims jwt.MapClaims) []int64 {
+	raw, ok := claims["team_ids"].([]interface{})
+	if !ok {
+		return nil
+	}
+	ids := make([]int64, 0, len(raw))
+	for _, v := range raw {
+		if id, ok := v.(float64); ok {
+			ids = append(ids, int64(id))
+		}
+	}
+	return ids
+}
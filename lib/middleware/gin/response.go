@@ -26,6 +26,7 @@ type Error struct {
 	Message  string `json:"message"`
 	Severity string `json:"message_severity"`
 	Action   *Action `json:"action"`
+	Details  interface{} `json:"details,omitempty"`
 }
 
 type Action struct {
@@ -50,12 +51,14 @@ func createErrorResponse(err i18n_err.I18nError, reqID, lang string) Response {
 			Message:  i18n.Message(lang, err.Error()),
 			Severity: "error",
 			Action:   nil,
+			Details:  i18n_err.Details(err),
 		},
 		Metadata: Meta{RequestId: reqID},
 	}
 }
 
-func getLanguage(c *gin.Context) string {
+// GetLanguage returns the language the caller asked for, see i18n.
+func GetLanguage(c *gin.Context) string {
 	if lang := c.GetHeader("X-User-Locale"); lang != "" {
 		return lang
 	}
@@ -74,23 +77,23 @@ func GINCreatedResponse(c *gin.Context, data interface{}) {
 }
 
 func GINBadRequestResponse(c *gin.Context) {
-	c.JSON(http.StatusBadRequest, createErrorResponse(i18n_err.ErrBadRequest, GetRequestID(c), getLanguage(c)))
+	c.JSON(http.StatusBadRequest, createErrorResponse(i18n_err.ErrBadRequest, GetRequestID(c), GetLanguage(c)))
 }
 
 func GINUnauthorizedResponse(c *gin.Context) {
-	c.JSON(http.StatusUnauthorized, createErrorResponse(i18n_err.ErrUnauthorized, GetRequestID(c), getLanguage(c)))
+	c.JSON(http.StatusUnauthorized, createErrorResponse(i18n_err.ErrUnauthorized, GetRequestID(c), GetLanguage(c)))
 }
 
 func GINForbiddenResponse(c *gin.Context) {
-	c.JSON(http.StatusForbidden, createErrorResponse(i18n_err.ErrForbidden, GetRequestID(c), getLanguage(c)))
+	c.JSON(http.StatusForbidden, createErrorResponse(i18n_err.ErrForbidden, GetRequestID(c), GetLanguage(c)))
 }
 
 func GINInternalErrorResponse(c *gin.Context) {
-	c.JSON(http.StatusInternalServerError, createErrorResponse(i18n_err.ErrInternalServer, GetRequestID(c), getLanguage(c)))
+	c.JSON(http.StatusInternalServerError, createErrorResponse(i18n_err.ErrInternalServer, GetRequestID(c), GetLanguage(c)))
 }
 
 func GINNotFoundResponse(c *gin.Context, err i18n_err.I18nError) {
-	c.JSON(http.StatusNotFound, createErrorResponse(err, GetRequestID(c), getLanguage(c)))
+	c.JSON(http.StatusNotFound, createErrorResponse(err, GetRequestID(c), GetLanguage(c)))
 }
 
 func GINErrorResponse(c *gin.Context, err error) {
@@ -98,21 +101,30 @@ func GINErrorResponse(c *gin.Context, err error) {
 	if errors.As(err, &i18nErr) {
 		statusCode := http.StatusInternalServerError
 		switch i18nErr.Error() {
-		case "err_team_not_found", "err_player_not_found", "err_match_not_found",
-			"err_product_not_found", "err_order_not_found", "err_user_not_found", "err_merchant_not_found":
+		case "err_team_not_found", "err_player_not_found", "err_match_not_found", "err_venue_not_found",
+			"err_product_not_found", "err_order_not_found", "err_user_not_found", "err_merchant_not_found",
+			"err_venue_block_not_found", "err_official_not_found", "err_staff_not_found",
+			"err_invitation_not_found", "err_session_not_found", "err_api_key_not_found":
 			statusCode = http.StatusNotFound
-		case "err_invalid_credentials", "err_unauthorized", "err_invalid_token":
+		case "err_invalid_credentials", "err_unauthorized", "err_invalid_token", "err_invalid_refresh_token", "err_invalid_api_key":
 			statusCode = http.StatusUnauthorized
-		case "err_forbidden":
+		case "err_forbidden", "err_user_disabled", "err_registration_closed", "err_api_key_ip_not_allowed",
+			"err_email_not_verified":
 			statusCode = http.StatusForbidden
 		case "err_bad_request", "err_validation_failed", "err_invalid_request",
 			"err_insufficient_stock", "err_jersey_number_taken", "err_match_already_has_result",
-			"err_match_not_completed", "err_same_team_match":
+			"err_match_not_completed", "err_same_team_match", "err_invalid_date_range", "err_match_not_scheduled",
+			"err_invalid_timezone", "err_staff_tenure_overlap", "err_user_self_modification",
+			"err_invalid_invitation_token", "err_invalid_ip_allowlist", "err_invalid_reset_token",
+			"err_invalid_verification_token", "err_player_not_in_match":
 			statusCode = http.StatusBadRequest
-		case "err_email_already_exists":
+		case "err_email_already_exists", "err_schedule_conflict", "err_venue_unavailable",
+			"err_official_assignment_conflict":
 			statusCode = http.StatusConflict
+		case "err_account_locked", "err_too_many_login_attempts", "err_too_many_mail_requests":
+			statusCode = http.StatusTooManyRequests
 		}
-		c.JSON(statusCode, createErrorResponse(i18nErr, GetRequestID(c), getLanguage(c)))
+		c.JSON(statusCode, createErrorResponse(i18nErr, GetRequestID(c), GetLanguage(c)))
 	} else {
 		GINInternalErrorResponse(c)
 	}
@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go-test/lib/logger"
+)
+
+type KeyStatus string
+
+const (
+	// KeyStatusPending keys are published and accepted for verification but
+	// not used for signing yet.
+	KeyStatusPending KeyStatus = "pending"
+	// KeyStatusActive is the single key new tokens are signed with.
+	KeyStatusActive KeyStatus = "active"
+	// KeyStatusRetired keys are accepted for verification until ExpiresAt.
+	KeyStatusRetired KeyStatus = "retired"
+)
+
+const (
+	keyRingFile = "keyring.json"
+	// legacyKeyFile is the single signing key used before keyrings, kept in
+	// the same directory. It is imported as the active key with kid
+	// legacyKid.
+	legacyKeyFile = "private.pem"
+	legacyKid     = "legacy"
+)
+
+var ErrNoSigningKey = errors.New("keyring has no active key")
+
+// SigningKeyProvider returns the key new tokens are signed with.
+type SigningKeyProvider interface {
+	SigningKey(ctx context.Context) (kid string, key *rsa.PrivateKey, err error)
+}
+
+// VerificationKeyProvider returns the keys tokens may be verified with.
+type VerificationKeyProvider interface {
+	PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, bool)
+	PublicKeys(ctx context.Context) []PublicKeyEntry
+}
+
+type KeyInfo struct {
+	Kid       string     `json:"kid"`
+	Status    KeyStatus  `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type PublicKeyEntry struct {
+	Kid string
+	Key *rsa.PublicKey
+}
+
+// KeyRing holds the RSA keys used to sign and verify JWTs. It is stored in a
+// directory as keyring.json plus one PKCS#8 PEM file per key, <kid>.pem.
+//
+// Keys are rotated in two steps so every instance and JWKS consumer knows a
+// key before tokens are signed with it: Generate adds a pending key and Rotate
+// later makes the newest pending key active. The previous active key keeps
+// verifying the tokens it signed until it expires.
+type KeyRing struct {
+	dir     string
+	keys    []KeyInfo
+	private map[string]*rsa.PrivateKey
+	removed []string
+}
+
+// LoadKeyRing reads the keyring in dir. A directory without keyring.json is
+// an empty keyring, or, when it still holds the private.pem of a deployment
+// from before keyrings, a keyring with that key active.
+func LoadKeyRing(dir string) (*KeyRing, error) {
+	ring := &KeyRing{dir: dir, private: map[string]*rsa.PrivateKey{}}
+
+	data, err := os.ReadFile(filepath.Join(dir, keyRingFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ring, ring.importLegacyKey()
+		}
+		return nil, fmt.Errorf("read keyring: %w", err)
+	}
+
+	var manifest struct {
+		Keys []KeyInfo `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parse keyring: %w", err)
+	}
+
+	for _, info := range manifest.Keys {
+		key, err := LoadRSAPrivateKey(filepath.Join(dir, info.Kid+".pem"))
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", info.Kid, err)
+		}
+		ring.private[info.Kid] = key
+	}
+	ring.keys = manifest.Keys
+
+	return ring, nil
+}
+
+// importLegacyKey adds the legacy private.pem, if any, as the active key.
+// Saving the keyring copies it to legacy.pem; private.pem is left in place.
+func (r *KeyRing) importLegacyKey() error {
+	path := filepath.Join(r.dir, legacyKeyFile)
+	stat, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("legacy key: %w", err)
+	}
+
+	key, err := LoadRSAPrivateKey(path)
+	if err != nil {
+		return fmt.Errorf("legacy key: %w", err)
+	}
+
+	r.keys = append(r.keys, KeyInfo{
+		Kid:       legacyKid,
+		Status:    KeyStatusActive,
+		CreatedAt: stat.ModTime().UTC(),
+	})
+	r.private[legacyKid] = key
+	return nil
+}
+
+func (r *KeyRing) Keys() []KeyInfo {
+	return append([]KeyInfo(nil), r.keys...)
+}
+
+// Generate adds a new 2048-bit key. It is pending unless the keyring has no
+// active key yet, in which case it becomes active right away.
+func (r *KeyRing) Generate(now time.Time) (KeyInfo, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return KeyInfo{}, fmt.Errorf("generate key: %w", err)
+	}
+
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return KeyInfo{}, err
+	}
+
+	info := KeyInfo{
+		Kid:       now.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix),
+		Status:    KeyStatusPending,
+		CreatedAt: now.UTC(),
+	}
+	if _, ok := r.active(); !ok {
+		info.Status = KeyStatusActive
+	}
+
+	r.keys = append(r.keys, info)
+	r.private[info.Kid] = key
+	return info, nil
+}
+
+// Rotate makes the newest pending key active. The previous active key is
+// retired and still verifies tokens for retireAfter, which should be at least
+// the access token lifetime plus the time other instances take to reload the
+// keyring, as they keep signing with the old key until then. Retired keys that have expired are removed.
+func (r *KeyRing) Rotate(now time.Time, retireAfter time.Duration) (KeyInfo, error) {
+	next := -1
+	for i, info := range r.keys {
+		if info.Status == KeyStatusPending && (next < 0 || info.CreatedAt.After(r.keys[next].CreatedAt)) {
+			next = i
+		}
+	}
+	if next < 0 {
+		return KeyInfo{}, errors.New("keyring has no pending key, generate one first")
+	}
+
+	expiresAt := now.UTC().Add(retireAfter)
+	for i := range r.keys {
+		if r.keys[i].Status == KeyStatusActive {
+			r.keys[i].Status = KeyStatusRetired
+			r.keys[i].ExpiresAt = &expiresAt
+		}
+	}
+	r.keys[next].Status = KeyStatusActive
+
+	kept := r.keys[:0]
+	for _, info := range r.keys {
+		if info.Status == KeyStatusRetired && !info.ExpiresAt.After(now) {
+			r.removed = append(r.removed, info.Kid)
+			delete(r.private, info.Kid)
+			continue
+		}
+		kept = append(kept, info)
+	}
+	r.keys = kept
+
+	return r.keys[indexOf(r.keys, KeyStatusActive)], nil
+}
+
+// Save writes new key files, then the manifest, then deletes removed key
+// files, so a concurrent LoadKeyRing always sees a consistent keyring.
+func (r *KeyRing) Save() error {
+	if err := os.MkdirAll(r.dir, 0o700); err != nil {
+		return err
+	}
+
+	for _, info := range r.keys {
+		path := filepath.Join(r.dir, info.Kid+".pem")
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+		der, err := x509.MarshalPKCS8PrivateKey(r.private[info.Kid])
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(struct {
+		Keys []KeyInfo `json:"keys"`
+	}{r.keys}, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(r.dir, keyRingFile+".tmp")
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(r.dir, keyRingFile)); err != nil {
+		return err
+	}
+
+	for _, kid := range r.removed {
+		if err := os.Remove(filepath.Join(r.dir, kid+".pem")); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	r.removed = nil
+
+	return nil
+}
+
+func (r *KeyRing) signingKey() (string, *rsa.PrivateKey, error) {
+	info, ok := r.active()
+	if !ok {
+		return "", nil, ErrNoSigningKey
+	}
+	return info.Kid, r.private[info.Kid], nil
+}
+
+// publicKeys lists the keys that verify tokens at now, in keyring order.
+func (r *KeyRing) publicKeys(now time.Time) []PublicKeyEntry {
+	entries := make([]PublicKeyEntry, 0, len(r.keys))
+	for _, info := range r.keys {
+		if info.Status == KeyStatusRetired && !info.ExpiresAt.After(now) {
+			continue
+		}
+		entries = append(entries, PublicKeyEntry{Kid: info.Kid, Key: &r.private[info.Kid].PublicKey})
+	}
+	return entries
+}
+
+func (r *KeyRing) active() (KeyInfo, bool) {
+	i := indexOf(r.keys, KeyStatusActive)
+	if i < 0 {
+		return KeyInfo{}, false
+	}
+	return r.keys[i], true
+}
+
+func indexOf(keys []KeyInfo, status KeyStatus) int {
+	for i, info := range keys {
+		if info.Status == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// KeyStore serves a keyring directory to a running server and reloads it
+// every reloadInterval, so keys generated or rotated with the keys command
+// are picked up without a restart.
+type KeyStore struct {
+	dir            string
+	reloadInterval time.Duration
+
+	mu       sync.Mutex
+	ring     *KeyRing
+	loadedAt time.Time
+}
+
+func NewKeyStore(dir string, reloadInterval time.Duration) (*KeyStore, error) {
+	ring, err := LoadKeyRing(dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ring.active(); !ok {
+		return nil, ErrNoSigningKey
+	}
+
+	return &KeyStore{
+		dir:            dir,
+		reloadInterval: reloadInterval,
+		ring:           ring,
+		loadedAt:       time.Now(),
+	}, nil
+}
+
+func (s *KeyStore) SigningKey(ctx context.Context) (string, *rsa.PrivateKey, error) {
+	return s.current(ctx).signingKey()
+}
+
+func (s *KeyStore) PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, bool) {
+	for _, entry := range s.current(ctx).publicKeys(time.Now()) {
+		if entry.Kid == kid {
+			return entry.Key, true
+		}
+	}
+	return nil, false
+}
+
+func (s *KeyStore) PublicKeys(ctx context.Context) []PublicKeyEntry {
+	return s.current(ctx).publicKeys(time.Now())
+}
+
+// current returns the keyring, reloading it when it is stale. A keyring that
+// fails to load is logged and the previous one is kept.
+func (s *KeyStore) current(ctx context.Context) *KeyRing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.loadedAt) < s.reloadInterval {
+		return s.ring
+	}
+	s.loadedAt = time.Now()
+
+	ring, err := LoadKeyRing(s.dir)
+	if err != nil {
+		logger.GetLogger(ctx).Error("reload keyring err: ", err)
+		return s.ring
+	}
+	if _, ok := ring.active(); !ok {
+		logger.GetLogger(ctx).Error("reload keyring err: ", ErrNoSigningKey)
+		return s.ring
+	}
+
+	s.ring = ring
+	return s.ring
+}
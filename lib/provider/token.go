@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// TokenProvider generates opaque secrets handed to clients (feed tokens,
+// refresh tokens, API keys). Only their hash should be stored.
+type TokenProvider interface {
+	NewToken() (string, error)
+}
+
+type RandomToken struct{}
+
+// NewToken returns 32 random bytes, hex encoded.
+func (r *RandomToken) NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashToken returns the hex SHA-256 of token, used as its lookup key.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_goals_team_id;
+ALTER TABLE goals DROP COLUMN IF EXISTS team_id;
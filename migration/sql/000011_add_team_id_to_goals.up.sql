@@ -0,0 +1,11 @@
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS team_id BIGINT NULL REFERENCES teams(id);
+
+-- Players have no transfer history, so an existing goal is only credited to
+-- the scorer's current team when that team played in the match. Goals of
+-- players who have since moved on keep a NULL team.
+UPDATE goals g SET team_id = p.team_id
+FROM players p, matches m
+WHERE p.id = g.player_id AND m.id = g.match_id AND g.team_id IS NULL
+AND p.team_id IN (m.home_team_id, m.away_team_id);
+
+CREATE INDEX IF NOT EXISTS idx_goals_team_id ON goals(team_id);
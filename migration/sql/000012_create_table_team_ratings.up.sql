@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS team_ratings (
+    id BIGSERIAL PRIMARY KEY,
+    team_id BIGINT NOT NULL REFERENCES teams(id),
+    opponent_id BIGINT NOT NULL REFERENCES teams(id),
+    match_id BIGINT NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    match_date DATE NOT NULL,
+    rating_before DOUBLE PRECISION NOT NULL,
+    rating_after DOUBLE PRECISION NOT NULL,
+    delta DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_ratings_team_date ON team_ratings(team_id, match_date);
+CREATE INDEX IF NOT EXISTS idx_team_ratings_match_id ON team_ratings(match_id);
+CREATE INDEX IF NOT EXISTS idx_team_ratings_deleted_at ON team_ratings(deleted_at);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_ratings_team_match
+    ON team_ratings(team_id, match_id)
+    WHERE deleted_at IS NULL;
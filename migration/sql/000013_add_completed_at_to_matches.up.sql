@@ -0,0 +1,6 @@
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP NULL;
+
+-- Results submitted before this migration have no recorded completion time;
+-- the last update is the closest approximation.
+UPDATE matches SET completed_at = updated_at
+WHERE status = 'completed' AND completed_at IS NULL;
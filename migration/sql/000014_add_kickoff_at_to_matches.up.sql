@@ -0,0 +1,18 @@
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS kickoff_at TIMESTAMPTZ NULL;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- Existing schedules were entered as Asia/Jakarta wall-clock time. Values of
+-- match_time that are not a valid HH:MM are treated as midnight.
+UPDATE matches SET kickoff_at = (match_date + CASE
+		WHEN match_time ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$' THEN match_time::time
+		ELSE TIME '00:00'
+	END) AT TIME ZONE timezone
+WHERE kickoff_at IS NULL;
+
+UPDATE matches SET match_time = '00:00'
+WHERE match_time !~ '^([01][0-9]|2[0-3]):[0-5][0-9]$';
+
+ALTER TABLE matches ALTER COLUMN kickoff_at SET NOT NULL;
+ALTER TABLE matches ALTER COLUMN timezone DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS idx_matches_kickoff_at ON matches(kickoff_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS feed_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    token_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_feed_tokens_user_id ON feed_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_feed_tokens_deleted_at ON feed_tokens(deleted_at);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_feed_tokens_token_hash
+    ON feed_tokens(token_hash)
+    WHERE deleted_at IS NULL;
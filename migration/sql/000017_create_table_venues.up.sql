@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS venues (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    city VARCHAR(255) NOT NULL,
+    capacity INT NOT NULL DEFAULT 0,
+    surface VARCHAR(20) NOT NULL DEFAULT 'grass',
+    latitude DOUBLE PRECISION NULL,
+    longitude DOUBLE PRECISION NULL,
+    timezone VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_venues_name ON venues(name);
+CREATE INDEX IF NOT EXISTS idx_venues_deleted_at ON venues(deleted_at);
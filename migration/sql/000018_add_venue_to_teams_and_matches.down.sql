@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_matches_venue_id_match_date;
+ALTER TABLE matches DROP COLUMN IF EXISTS venue_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS home_venue_id;
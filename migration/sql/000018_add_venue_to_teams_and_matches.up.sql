@@ -0,0 +1,4 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS home_venue_id BIGINT NULL REFERENCES venues(id);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS venue_id BIGINT NULL REFERENCES venues(id);
+
+CREATE INDEX IF NOT EXISTS idx_matches_venue_id_match_date ON matches(venue_id, match_date);
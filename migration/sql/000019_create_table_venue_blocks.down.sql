@@ -0,0 +1 @@
+DROP TABLE IF EXISTS venue_blocks;
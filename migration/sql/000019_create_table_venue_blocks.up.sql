@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS venue_blocks (
+    id BIGSERIAL PRIMARY KEY,
+    venue_id BIGINT NOT NULL REFERENCES venues(id),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CONSTRAINT chk_venue_blocks_dates CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_venue_blocks_venue_id_dates ON venue_blocks(venue_id, start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_venue_blocks_deleted_at ON venue_blocks(deleted_at);
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS officials (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    home_city VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_officials_name ON officials(name);
+CREATE INDEX IF NOT EXISTS idx_officials_deleted_at ON officials(deleted_at);
+
+CREATE TABLE IF NOT EXISTS match_officials (
+    id BIGSERIAL PRIMARY KEY,
+    match_id BIGINT NOT NULL REFERENCES matches(id),
+    official_id BIGINT NOT NULL REFERENCES officials(id),
+    role VARCHAR(30) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_officials_match_id ON match_officials(match_id);
+CREATE INDEX IF NOT EXISTS idx_match_officials_official_id ON match_officials(official_id);
+CREATE INDEX IF NOT EXISTS idx_match_officials_deleted_at ON match_officials(deleted_at);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_match_officials_match_id_official_id
+    ON match_officials(match_id, official_id)
+    WHERE deleted_at IS NULL;
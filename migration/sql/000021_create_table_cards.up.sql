@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS cards (
+    id BIGSERIAL PRIMARY KEY,
+    match_id BIGINT NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    player_id BIGINT NOT NULL REFERENCES players(id),
+    team_id BIGINT NOT NULL REFERENCES teams(id),
+    card_type VARCHAR(10) NOT NULL,
+    card_minute INT NOT NULL CHECK (card_minute >= 1 AND card_minute <= 120),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_cards_match_id ON cards(match_id);
+CREATE INDEX IF NOT EXISTS idx_cards_player_id ON cards(player_id);
+CREATE INDEX IF NOT EXISTS idx_cards_deleted_at ON cards(deleted_at);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS team_staff (
+    id BIGSERIAL PRIMARY KEY,
+    team_id BIGINT NOT NULL REFERENCES teams(id),
+    name VARCHAR(255) NOT NULL,
+    role VARCHAR(30) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CONSTRAINT chk_team_staff_dates CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_staff_team_id ON team_staff(team_id);
+CREATE INDEX IF NOT EXISTS idx_team_staff_team_id_dates ON team_staff(team_id, start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_team_staff_deleted_at ON team_staff(deleted_at);
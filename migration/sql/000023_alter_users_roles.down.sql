@@ -0,0 +1,6 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+
+UPDATE users SET role = 'admin';
+
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'admin';
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('admin'));
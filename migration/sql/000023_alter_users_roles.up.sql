@@ -0,0 +1,9 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+
+-- Registration used to be open and always granted admin, so legacy admins are
+-- not trusted: the first super_admin is set with cmd/users set-role.
+UPDATE users SET role = 'viewer' WHERE role = 'admin';
+
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'viewer';
+ALTER TABLE users ADD CONSTRAINT users_role_check
+    CHECK (role IN ('super_admin', 'league_admin', 'team_manager', 'official', 'viewer'));
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS user_teams (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    team_id BIGINT NOT NULL REFERENCES teams(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_teams_user_id ON user_teams(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_teams_deleted_at ON user_teams(deleted_at);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_teams_user_id_team_id
+    ON user_teams(user_id, team_id)
+    WHERE deleted_at IS NULL;
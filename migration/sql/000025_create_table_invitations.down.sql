@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS invitations;
+
+DROP INDEX IF EXISTS idx_users_email_active;
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+
+ALTER TABLE users DROP COLUMN IF EXISTS disabled_at;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS revoked_tokens;
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS ip_address;
+ALTER TABLE sessions DROP COLUMN IF EXISTS user_agent;
+ALTER TABLE sessions DROP COLUMN IF EXISTS last_used_at;
+ALTER TABLE sessions DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE sessions DROP COLUMN IF EXISTS access_expires_at;
+ALTER TABLE sessions DROP COLUMN IF EXISTS access_jti;
@@ -25,18 +25,114 @@ type (
 	}
 
 	JWT struct {
-		PrivateKeyPath string `mapstructure:"JWT_PRIVATE_KEY_PATH" validate:"required"`
-		PublicKeyPath  string `mapstructure:"JWT_PUBLIC_KEY_PATH" validate:"required"`
+		// KeysDir is the keyring directory managed by cmd/keys. It must be
+		// shared by every instance.
+		KeysDir string `mapstructure:"JWT_KEYS_DIR" validate:"required"`
+		// KeysReloadInterval is how often the keyring is re-read, i.e. how
+		// long a generated or rotated key may take to be picked up.
+		KeysReloadInterval time.Duration `mapstructure:"JWT_KEYS_RELOAD_INTERVAL" validate:"required"`
+	}
+
+	Auth struct {
+		// OpenRegistration enables POST /v1/auth/register. Leave it off
+		// outside development; users join through invitations instead.
+		OpenRegistration bool `mapstructure:"AUTH_OPEN_REGISTRATION"`
+		// InvitationTTL is how long an invitation token can be accepted.
+		InvitationTTL time.Duration `mapstructure:"AUTH_INVITATION_TTL" validate:"required"`
+		// AccessTokenTTL is the lifetime of a JWT. Keep it short; clients
+		// renew it with a refresh token.
+		AccessTokenTTL time.Duration `mapstructure:"AUTH_ACCESS_TOKEN_TTL" validate:"required"`
+		// RefreshTokenTTL is how long a session may stay idle before the
+		// user has to log in again.
+		RefreshTokenTTL time.Duration `mapstructure:"AUTH_REFRESH_TOKEN_TTL" validate:"required"`
+		// RevocationSyncInterval is how often revoked tokens are reloaded
+		// from the database, i.e. how long a revocation made on another
+		// instance may take to apply.
+		RevocationSyncInterval time.Duration `mapstructure:"AUTH_REVOCATION_SYNC_INTERVAL" validate:"required"`
+		// PasswordResetTTL is how long a password reset link can be used.
+		PasswordResetTTL time.Duration `mapstructure:"AUTH_PASSWORD_RESET_TTL" validate:"required"`
+		// EmailVerificationTTL is how long an email verification link can
+		// be used.
+		EmailVerificationTTL time.Duration `mapstructure:"AUTH_EMAIL_VERIFICATION_TTL" validate:"required"`
+		// LoginAttemptStore keeps failed logins in the database, shared by
+		// every instance, or in memory for a single instance.
+		LoginAttemptStore string `mapstructure:"AUTH_LOGIN_ATTEMPT_STORE" validate:"required,oneof=database memory"`
+		// LoginMaxFailures failed logins to one account within
+		// LoginFailureWindow lock it for LoginLockout.
+		LoginMaxFailures int `mapstructure:"AUTH_LOGIN_MAX_FAILURES" validate:"required,min=1"`
+		// LoginIPMaxFailures failed logins from one IP, to any account,
+		// lock the IP the same way. Set it above LoginMaxFailures since many
+		// users may share an IP behind a NAT.
+		LoginIPMaxFailures int           `mapstructure:"AUTH_LOGIN_IP_MAX_FAILURES" validate:"required,min=1"`
+		LoginFailureWindow time.Duration `mapstructure:"AUTH_LOGIN_FAILURE_WINDOW" validate:"required"`
+		LoginLockout       time.Duration `mapstructure:"AUTH_LOGIN_LOCKOUT" validate:"required"`
+		// LoginDelay is how long an account waits after a failed login. It
+		// doubles with every further failure. Zero disables the delay.
+		LoginDelay time.Duration `mapstructure:"AUTH_LOGIN_DELAY"`
+		// MailCooldown is how long one email has to wait between two
+		// password reset or verification link requests.
+		MailCooldown time.Duration `mapstructure:"AUTH_MAIL_COOLDOWN" validate:"required"`
+	}
+
+	Mail struct {
+		// Driver is smtp, or file to write mails to FileDir (or only log
+		// them when FileDir is empty) in development.
+		Driver  string `mapstructure:"MAIL_DRIVER" validate:"required,oneof=smtp file"`
+		From    string `mapstructure:"MAIL_FROM" validate:"required"`
+		FileDir string `mapstructure:"MAIL_FILE_DIR"`
+		// LinkBaseURL is the frontend that opens the links in mails, e.g.
+		// <LinkBaseURL>/reset-password?token=...
+		LinkBaseURL  string `mapstructure:"MAIL_LINK_BASE_URL" validate:"required,url"`
+		SMTPHost     string `mapstructure:"SMTP_HOST" validate:"required_if=Driver smtp"`
+		SMTPPort     int    `mapstructure:"SMTP_PORT" validate:"required_if=Driver smtp"`
+		SMTPUsername string `mapstructure:"SMTP_USERNAME"`
+		SMTPPassword string `mapstructure:"SMTP_PASSWORD"`
+	}
+
+	Elo struct {
+		InitialRating      float64 `mapstructure:"ELO_INITIAL_RATING" validate:"required"`
+		KFactor            float64 `mapstructure:"ELO_K_FACTOR" validate:"required"`
+		HomeAdvantage      float64 `mapstructure:"ELO_HOME_ADVANTAGE"`
+		GoalDiffMultiplier float64 `mapstructure:"ELO_GOAL_DIFF_MULTIPLIER"`
+	}
+
+	Schedule struct {
+		// MinRestDays is the minimum number of full days a team must rest
+		// between two matches. Zero only forbids two matches on the same day.
+		MinRestDays int `mapstructure:"SCHEDULE_MIN_REST_DAYS" validate:"min=0"`
+	}
+
+	Officials struct {
+		// MaxMatchesPerWeek is the most matches an official may be assigned
+		// to in one Monday to Sunday week. Zero means no limit.
+		MaxMatchesPerWeek int `mapstructure:"OFFICIAL_MAX_MATCHES_PER_WEEK" validate:"min=0"`
+		// AllowHomeCity disables the rule that officials may not officiate a
+		// team from their home city.
+		AllowHomeCity bool `mapstructure:"OFFICIAL_ALLOW_HOME_CITY"`
 	}
 
 	Configuration struct {
 		ServiceName string      `mapstructure:"SERVICE_NAME"`
 		Postgres    Postgres    `mapstructure:",squash"`
 		JWT         JWT         `mapstructure:",squash"`
+		Auth        Auth        `mapstructure:",squash"`
+		Mail        Mail        `mapstructure:",squash"`
 		Translation Translation `mapstructure:",squash"`
+		Elo         Elo         `mapstructure:",squash"`
+		Schedule    Schedule    `mapstructure:",squash"`
+		Officials   Officials   `mapstructure:",squash"`
 		Environment string      `mapstructure:"ENV" validate:"required,oneof=development staging production"`
 		BindAddress int         `mapstructure:"BIND_ADDRESS" validate:"required"`
 		LogLevel    int         `mapstructure:"LOG_LEVEL" validate:"required"`
+
+		// TrustedProxies lists the proxy IPs and CIDR ranges whose
+		// X-Forwarded-For header is used for the client IP, comma separated.
+		// Empty trusts none, so the address of the connection is used.
+		TrustedProxies []string `mapstructure:"TRUSTED_PROXIES"`
+
+		// DefaultTimezone is the IANA timezone used for matches created
+		// without one and as the process local time.
+		DefaultTimezone string `mapstructure:"DEFAULT_TIMEZONE" validate:"required"`
 	}
 )
 
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+type AccountTokenPurpose string
+
+const (
+	AccountTokenPasswordReset     AccountTokenPurpose = "password_reset"
+	AccountTokenEmailVerification AccountTokenPurpose = "email_verification"
+)
+
+// AccountToken is a single-use token mailed to a user to reset their password
+// or verify their email. Only the SHA-256 of the token is stored.
+type AccountToken struct {
+	ModelID
+	ModelLogTime
+	UserID    int64               `db:"user_id"`
+	Purpose   AccountTokenPurpose `db:"purpose"`
+	TokenHash string              `db:"token_hash"`
+	ExpiresAt time.Time           `db:"expires_at"`
+	UsedAt    *time.Time          `db:"used_at"`
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// APIKey authenticates a machine client, such as scoreboard hardware or a
+// partner site, with the X-API-Key header instead of a user login. Only the
+// SHA-256 of the key is stored; KeyPrefix identifies it in listings.
+type APIKey struct {
+	ModelID
+	ModelLogTime
+	Name      string         `db:"name"`
+	KeyHash   string         `db:"key_hash"`
+	KeyPrefix string         `db:"key_prefix"`
+	Scopes    pq.StringArray `db:"scopes"`
+	// AllowedIPs lists the IPs and CIDR ranges the key may be used from.
+	// Empty allows any address.
+	AllowedIPs pq.StringArray `db:"allowed_ips"`
+	CreatedBy  int64          `db:"created_by"`
+	ExpiresAt  time.Time      `db:"expires_at"`
+	LastUsedAt *time.Time     `db:"last_used_at"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+type CardType string
+
+const (
+	CardTypeYellow CardType = "yellow"
+	CardTypeRed    CardType = "red"
+)
+
+type Card struct {
+	ModelID
+	ModelLogTime
+	MatchID    int64    `db:"match_id"`
+	PlayerID   int64    `db:"player_id"`
+	TeamID     int64    `db:"team_id"`
+	CardType   CardType `db:"card_type"`
+	CardMinute int      `db:"card_minute"`
+}
@@ -0,0 +1,10 @@
+package entity
+
+// FeedToken authenticates calendar feed requests, which cannot carry a JWT.
+// Only the SHA-256 of the token is stored.
+type FeedToken struct {
+	ModelID
+	ModelLogTime
+	UserID    int64  `db:"user_id"`
+	TokenHash string `db:"token_hash"`
+}
@@ -3,7 +3,15 @@ package entity
 type Goal struct {
 	ModelID
 	ModelLogTime
-	MatchID    int64 `db:"match_id"`
-	PlayerID   int64 `db:"player_id"`
-	GoalMinute int   `db:"goal_minute"`
+	MatchID    int64  `db:"match_id"`
+	PlayerID   int64  `db:"player_id"`
+	TeamID     *int64 `db:"team_id"`
+	GoalMinute int    `db:"goal_minute"`
+}
+
+type GoalScorerStat struct {
+	PlayerID   int64  `db:"player_id"`
+	PlayerName string `db:"player_name"`
+	TeamID     *int64 `db:"team_id"`
+	Goals      int    `db:"goals"`
 }
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Invitation lets a new user set a password and join with a role chosen by
+// an admin. Only the SHA-256 of the token is stored; it can be used once
+// before ExpiresAt.
+type Invitation struct {
+	ModelID
+	ModelLogTime
+	Email      string        `db:"email"`
+	Role       UserRole      `db:"role"`
+	TeamIDs    pq.Int64Array `db:"team_ids"`
+	TokenHash  string        `db:"token_hash"`
+	InvitedBy  int64         `db:"invited_by"`
+	ExpiresAt  time.Time     `db:"expires_at"`
+	AcceptedAt *time.Time    `db:"accepted_at"`
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// LoginAttempt counts the recent failed logins of one key, an account or a
+// client IP, see service.LoginThrottle.
+type LoginAttempt struct {
+	ModelID
+	ModelLogTime
+	Key          string     `db:"attempt_key"`
+	Failures     int        `db:"failures"`
+	LastFailedAt time.Time  `db:"last_failed_at"`
+	LockedUntil  *time.Time `db:"locked_until"`
+}
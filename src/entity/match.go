@@ -9,23 +9,78 @@ const (
 	MatchStatusCompleted MatchStatus = "completed"
 )
 
+// Match kickoff is KickoffAt, scheduled in the IANA Timezone. MatchDate and
+// MatchTime are the legacy local date and HH:MM of the kickoff in Timezone,
+// kept in sync with KickoffAt.
 type Match struct {
 	ModelID
 	ModelLogTime
-	HomeTeamID int64       `db:"home_team_id"`
-	AwayTeamID int64       `db:"away_team_id"`
-	MatchDate  time.Time   `db:"match_date"`
-	MatchTime  string      `db:"match_time"`
-	HomeScore  *int        `db:"home_score"`
-	AwayScore  *int        `db:"away_score"`
-	Status     MatchStatus `db:"status"`
+	HomeTeamID  int64       `db:"home_team_id"`
+	AwayTeamID  int64       `db:"away_team_id"`
+	MatchDate   time.Time   `db:"match_date"`
+	MatchTime   string      `db:"match_time"`
+	KickoffAt   time.Time   `db:"kickoff_at"`
+	Timezone    string      `db:"timezone"`
+	VenueID     *int64      `db:"venue_id"`
+	HomeScore   *int        `db:"home_score"`
+	AwayScore   *int        `db:"away_score"`
+	Status      MatchStatus `db:"status"`
+	CompletedAt *time.Time  `db:"completed_at"`
+	// Sequence is bumped on every reschedule or deletion, for calendar feeds.
+	Sequence int `db:"sequence"`
+}
+
+type MatchFeedEntry struct {
+	Match
+	HomeTeamName string  `db:"home_team_name"`
+	AwayTeamName string  `db:"away_team_name"`
+	HomeTeamCity string  `db:"home_team_city"`
+	VenueName    *string `db:"venue_name"`
+	VenueCity    *string `db:"venue_city"`
 }
 
 type MatchWinStat struct {
-	ID         int64  `db:"id"`
-	HomeTeamID int64  `db:"home_team_id"`
-	AwayTeamID int64  `db:"away_team_id"`
-	HomeScore  *int   `db:"home_score"`
-	AwayScore  *int   `db:"away_score"`
-	Status     string `db:"status"`
+	ID         int64     `db:"id"`
+	HomeTeamID int64     `db:"home_team_id"`
+	AwayTeamID int64     `db:"away_team_id"`
+	MatchDate  time.Time `db:"match_date"`
+	HomeScore  *int      `db:"home_score"`
+	AwayScore  *int      `db:"away_score"`
+	Status     string    `db:"status"`
+}
+
+type TeamStatMatch struct {
+	MatchID      *int64     `db:"match_id"`
+	MatchDate    *time.Time `db:"match_date"`
+	OpponentID   *int64     `db:"opponent_id"`
+	OpponentName *string    `db:"opponent_name"`
+	OpponentLogo *string    `db:"opponent_logo"`
+	GoalsFor     *int       `db:"goals_for"`
+	GoalsAgainst *int       `db:"goals_against"`
+}
+
+type TeamStat struct {
+	Played            int           `db:"played"`
+	HomeWins          int           `db:"home_wins"`
+	HomeDraws         int           `db:"home_draws"`
+	HomeLosses        int           `db:"home_losses"`
+	AwayWins          int           `db:"away_wins"`
+	AwayDraws         int           `db:"away_draws"`
+	AwayLosses        int           `db:"away_losses"`
+	GoalsFor          int           `db:"goals_for"`
+	GoalsAgainst      int           `db:"goals_against"`
+	CleanSheets       int           `db:"clean_sheets"`
+	FailedToScore     int           `db:"failed_to_score"`
+	AverageGoalMinute *float64      `db:"average_goal_minute"`
+	BiggestWin        TeamStatMatch `db:"biggest_win"`
+	BiggestLoss       TeamStatMatch `db:"biggest_loss"`
+}
+
+type HeadToHeadStat struct {
+	Played        int `db:"played"`
+	TeamWins      int `db:"team_wins"`
+	Draws         int `db:"draws"`
+	OpponentWins  int `db:"opponent_wins"`
+	TeamGoals     int `db:"team_goals"`
+	OpponentGoals int `db:"opponent_goals"`
 }
@@ -0,0 +1,50 @@
+package entity
+
+import "time"
+
+type OfficialRole string
+
+const (
+	OfficialRoleReferee          OfficialRole = "referee"
+	OfficialRoleAssistantReferee OfficialRole = "assistant_referee"
+	OfficialRoleFourthOfficial   OfficialRole = "fourth_official"
+	OfficialRoleVAR              OfficialRole = "var"
+)
+
+type Official struct {
+	ModelID
+	ModelLogTime
+	Name     string `db:"name"`
+	Email    string `db:"email"`
+	HomeCity string `db:"home_city"`
+}
+
+type MatchOfficial struct {
+	ModelID
+	ModelLogTime
+	MatchID    int64        `db:"match_id"`
+	OfficialID int64        `db:"official_id"`
+	Role       OfficialRole `db:"role"`
+}
+
+type MatchOfficialDetail struct {
+	MatchOfficial
+	OfficialName string `db:"official_name"`
+}
+
+// OfficialAssignment is one match of an official with the cards shown in it.
+// Cards are only counted for the referee, who issues them.
+type OfficialAssignment struct {
+	MatchID      int64        `db:"match_id"`
+	MatchDate    time.Time    `db:"match_date"`
+	KickoffAt    time.Time    `db:"kickoff_at"`
+	Timezone     string       `db:"timezone"`
+	Status       MatchStatus  `db:"status"`
+	HomeTeamID   int64        `db:"home_team_id"`
+	HomeTeamName string       `db:"home_team_name"`
+	AwayTeamID   int64        `db:"away_team_id"`
+	AwayTeamName string       `db:"away_team_name"`
+	Role         OfficialRole `db:"role"`
+	YellowCards  int          `db:"yellow_cards"`
+	RedCards     int          `db:"red_cards"`
+}
@@ -0,0 +1,62 @@
+package entity
+
+type Permission string
+
+const (
+	PermissionRead             Permission = "read"
+	PermissionTeamsWrite       Permission = "teams:write"
+	PermissionTeamsUpdate      Permission = "teams:update"
+	PermissionPlayersWrite     Permission = "players:write"
+	PermissionStaffWrite       Permission = "staff:write"
+	PermissionMatchesWrite     Permission = "matches:write"
+	PermissionResultsWrite     Permission = "results:write"
+	PermissionVenuesWrite      Permission = "venues:write"
+	PermissionOfficialsWrite   Permission = "officials:write"
+	PermissionScheduleOverride Permission = "schedule:override"
+	PermissionUsersManage      Permission = "users:manage"
+)
+
+// rolePermissions is the permission matrix. Every role can read; writes are
+// granted per role and checked per route.
+var rolePermissions = map[UserRole][]Permission{
+	UserRoleSuperAdmin: {
+		PermissionRead, PermissionTeamsWrite, PermissionTeamsUpdate, PermissionPlayersWrite, PermissionStaffWrite,
+		PermissionMatchesWrite, PermissionResultsWrite, PermissionVenuesWrite, PermissionOfficialsWrite,
+		PermissionScheduleOverride, PermissionUsersManage,
+	},
+	UserRoleLeagueAdmin: {
+		PermissionRead, PermissionTeamsWrite, PermissionTeamsUpdate, PermissionPlayersWrite, PermissionStaffWrite,
+		PermissionMatchesWrite, PermissionResultsWrite, PermissionVenuesWrite, PermissionOfficialsWrite,
+		PermissionScheduleOverride,
+	},
+	UserRoleTeamManager: {
+		PermissionRead, PermissionTeamsUpdate, PermissionPlayersWrite, PermissionStaffWrite,
+	},
+	UserRoleOfficial: {
+		PermissionRead, PermissionResultsWrite,
+	},
+	UserRoleViewer: {
+		PermissionRead,
+	},
+}
+
+func (r UserRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can reports whether the role is granted the permission.
+func (r UserRole) Can(p Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize reports whether the named role is granted the named permission.
+// It is the authorizer of the JWT middleware.
+func Authorize(role, permission string) bool {
+	return UserRole(role).Can(Permission(permission))
+}
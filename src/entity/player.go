@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 type PlayerPosition string
 
 const (
@@ -19,3 +21,31 @@ type Player struct {
 	Position     PlayerPosition `db:"position"`
 	JerseyNumber int            `db:"jersey_number"`
 }
+
+type PlayerStatSummary struct {
+	MatchesWithGoal int        `db:"matches_with_goal"`
+	Goals           int        `db:"goals"`
+	FirstGoalDate   *time.Time `db:"first_goal_date"`
+	LastGoalDate    *time.Time `db:"last_goal_date"`
+}
+
+type PlayerTeamStat struct {
+	PlayerStatSummary
+	TeamID   int64  `db:"team_id"`
+	TeamName string `db:"team_name"`
+}
+
+type PlayerSeasonStat struct {
+	PlayerStatSummary
+	Season int `db:"season"`
+}
+
+type PlayerLeaderboardEntry struct {
+	PlayerID        int64  `db:"player_id"`
+	PlayerName      string `db:"player_name"`
+	TeamID          int64  `db:"team_id"`
+	TeamName        string `db:"team_name"`
+	TeamLogo        string `db:"team_logo"`
+	MatchesWithGoal int    `db:"matches_with_goal"`
+	Goals           int    `db:"goals"`
+}
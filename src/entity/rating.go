@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+type TeamRating struct {
+	ModelID
+	ModelLogTime
+	TeamID       int64     `db:"team_id"`
+	OpponentID   int64     `db:"opponent_id"`
+	MatchID      int64     `db:"match_id"`
+	MatchDate    time.Time `db:"match_date"`
+	RatingBefore float64   `db:"rating_before"`
+	RatingAfter  float64   `db:"rating_after"`
+	Delta        float64   `db:"delta"`
+}
+
+type TeamRatingHistory struct {
+	TeamRating
+	OpponentName string `db:"opponent_name"`
+}
+
+type TeamRanking struct {
+	TeamID        int64      `db:"team_id"`
+	TeamName      string     `db:"team_name"`
+	TeamLogo      string     `db:"team_logo"`
+	Rating        float64    `db:"rating"`
+	LastDelta     float64    `db:"last_delta"`
+	Matches       int        `db:"matches"`
+	LastMatchDate *time.Time `db:"last_match_date"`
+}
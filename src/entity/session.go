@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// Session is one login of a user. Its refresh tokens form a family: every
+// refresh uses up the current token and issues the next one, and presenting
+// a used token revokes the whole session.
+type Session struct {
+	ModelID
+	ModelLogTime
+	UserID    int64      `db:"user_id"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	// AccessJTI is the jti of the latest access token issued for the
+	// session; it is revoked together with the session.
+	AccessJTI       string    `db:"access_jti"`
+	AccessExpiresAt time.Time `db:"access_expires_at"`
+	// ExpiresAt is when the latest refresh token expires.
+	ExpiresAt  time.Time `db:"expires_at"`
+	LastUsedAt time.Time `db:"last_used_at"`
+	UserAgent  string    `db:"user_agent"`
+	IPAddress  string    `db:"ip_address"`
+}
+
+// RefreshToken is one token of a session's family. Only the SHA-256 of the
+// token is stored.
+type RefreshToken struct {
+	ModelID
+	ModelLogTime
+	SessionID int64      `db:"session_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+}
+
+// RevokedToken is an access token that must be rejected before it expires.
+type RevokedToken struct {
+	ModelID
+	ModelLogTime
+	JTI       string    `db:"jti"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
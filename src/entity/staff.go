@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+type StaffRole string
+
+const (
+	StaffRoleHeadCoach        StaffRole = "head_coach"
+	StaffRoleAssistantCoach   StaffRole = "assistant_coach"
+	StaffRoleGoalkeepingCoach StaffRole = "goalkeeping_coach"
+	StaffRolePhysio           StaffRole = "physio"
+)
+
+// IsCoach reports whether the role is part of the coaching staff.
+func (r StaffRole) IsCoach() bool {
+	return r != StaffRolePhysio
+}
+
+// StaffMember is one tenure of a person in a team's backroom staff. A nil
+// EndDate means the tenure is ongoing.
+type StaffMember struct {
+	ModelID
+	ModelLogTime
+	TeamID    int64      `db:"team_id"`
+	Name      string     `db:"name"`
+	Role      StaffRole  `db:"role"`
+	StartDate time.Time  `db:"start_date"`
+	EndDate   *time.Time `db:"end_date"`
+}
@@ -8,4 +8,5 @@ type Team struct {
 	YearFounded int    `db:"year_founded"`
 	Address     string `db:"address"`
 	City        string `db:"city"`
+	HomeVenueID *int64 `db:"home_venue_id"`
 }
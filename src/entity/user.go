@@ -1,16 +1,40 @@
 package entity
 
+import "time"
+
 type UserRole string
 
 const (
-	UserRoleAdmin UserRole = "admin"
+	UserRoleSuperAdmin  UserRole = "super_admin"
+	UserRoleLeagueAdmin UserRole = "league_admin"
+	UserRoleTeamManager UserRole = "team_manager"
+	UserRoleOfficial    UserRole = "official"
+	UserRoleViewer      UserRole = "viewer"
 )
 
+// IsTeamScoped reports whether the role only applies to the teams the user
+// manages, see UserTeam.
+func (r UserRole) IsTeamScoped() bool {
+	return r == UserRoleTeamManager
+}
+
 type User struct {
 	ModelID
 	ModelLogTime
-	Name     string   `db:"name"`
-	Email    string   `db:"email"`
-	Password string   `db:"password"`
-	Role     UserRole `db:"role"`
+	Name       string     `db:"name"`
+	Email      string     `db:"email"`
+	Password   string     `db:"password"`
+	Role       UserRole   `db:"role"`
+	DisabledAt *time.Time `db:"disabled_at"`
+	// EmailVerifiedAt is nil until the user confirms their email. Users
+	// who registered themselves can not log in before that.
+	EmailVerifiedAt *time.Time `db:"email_verified_at"`
+}
+
+// UserTeam grants a team-scoped user access to one team.
+type UserTeam struct {
+	ModelID
+	ModelLogTime
+	UserID int64 `db:"user_id"`
+	TeamID int64 `db:"team_id"`
 }
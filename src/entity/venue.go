@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+type VenueSurface string
+
+const (
+	VenueSurfaceGrass      VenueSurface = "grass"
+	VenueSurfaceArtificial VenueSurface = "artificial"
+	VenueSurfaceHybrid     VenueSurface = "hybrid"
+)
+
+type Venue struct {
+	ModelID
+	ModelLogTime
+	Name      string       `db:"name"`
+	City      string       `db:"city"`
+	Capacity  int          `db:"capacity"`
+	Surface   VenueSurface `db:"surface"`
+	Latitude  *float64     `db:"latitude"`
+	Longitude *float64     `db:"longitude"`
+	Timezone  string       `db:"timezone"`
+}
+
+type VenueBlockReason string
+
+const (
+	VenueBlockReasonMaintenance   VenueBlockReason = "maintenance"
+	VenueBlockReasonEvent         VenueBlockReason = "event"
+	VenueBlockReasonSharedTenancy VenueBlockReason = "shared_tenancy"
+	VenueBlockReasonOther         VenueBlockReason = "other"
+)
+
+// VenueBlock makes a venue unavailable for matches from StartDate to EndDate
+// inclusive.
+type VenueBlock struct {
+	ModelID
+	ModelLogTime
+	VenueID   int64            `db:"venue_id"`
+	StartDate time.Time        `db:"start_date"`
+	EndDate   time.Time        `db:"end_date"`
+	Reason    VenueBlockReason `db:"reason"`
+	Note      string           `db:"note"`
+}
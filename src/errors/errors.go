@@ -6,26 +6,64 @@ import (
 
 var (
 	// Authentication
-	ErrInvalidCredentials = i18n_err.NewI18nError("err_invalid_credentials")
-	ErrEmailAlreadyExists = i18n_err.NewI18nError("err_email_already_exists")
-	ErrUnauthorized       = i18n_err.NewI18nError("err_unauthorized")
-	ErrInvalidToken       = i18n_err.NewI18nError("err_invalid_token")
-	ErrForbidden          = i18n_err.NewI18nError("err_forbidden")
+	ErrInvalidCredentials   = i18n_err.NewI18nError("err_invalid_credentials")
+	ErrEmailAlreadyExists   = i18n_err.NewI18nError("err_email_already_exists")
+	ErrUnauthorized         = i18n_err.NewI18nError("err_unauthorized")
+	ErrInvalidToken         = i18n_err.NewI18nError("err_invalid_token")
+	ErrInvalidRefreshToken  = i18n_err.NewI18nError("err_invalid_refresh_token")
+	ErrForbidden            = i18n_err.NewI18nError("err_forbidden")
+	ErrSessionNotFound      = i18n_err.NewI18nError("err_session_not_found")
+	ErrInvalidAPIKey        = i18n_err.NewI18nError("err_invalid_api_key")
+	ErrAPIKeyIPNotAllowed   = i18n_err.NewI18nError("err_api_key_ip_not_allowed")
+	ErrAPIKeyNotFound       = i18n_err.NewI18nError("err_api_key_not_found")
+	ErrInvalidIPAllowlist   = i18n_err.NewI18nError("err_invalid_ip_allowlist")
+	ErrAccountLocked        = i18n_err.NewI18nError("err_account_locked")
+	ErrTooManyLoginAttempts = i18n_err.NewI18nError("err_too_many_login_attempts")
+	ErrTooManyMailRequests  = i18n_err.NewI18nError("err_too_many_mail_requests")
 
 	// Validation
 	ErrValidationFailed = i18n_err.NewI18nError("err_validation_failed")
 	ErrInvalidRequest   = i18n_err.NewI18nError("err_invalid_request")
+	ErrInvalidDateRange = i18n_err.NewI18nError("err_invalid_date_range")
+	ErrInvalidTimezone  = i18n_err.NewI18nError("err_invalid_timezone")
+
+	// User
+	ErrUserNotFound             = i18n_err.NewI18nError("err_user_not_found")
+	ErrUserDisabled             = i18n_err.NewI18nError("err_user_disabled")
+	ErrUserSelfModification     = i18n_err.NewI18nError("err_user_self_modification")
+	ErrRegistrationClosed       = i18n_err.NewI18nError("err_registration_closed")
+	ErrInvitationNotFound       = i18n_err.NewI18nError("err_invitation_not_found")
+	ErrInvalidInvitationToken   = i18n_err.NewI18nError("err_invalid_invitation_token")
+	ErrEmailNotVerified         = i18n_err.NewI18nError("err_email_not_verified")
+	ErrInvalidResetToken        = i18n_err.NewI18nError("err_invalid_reset_token")
+	ErrInvalidVerificationToken = i18n_err.NewI18nError("err_invalid_verification_token")
 
 	// Team
 	ErrTeamNotFound = i18n_err.NewI18nError("err_team_not_found")
 
+	// Venue
+	ErrVenueNotFound      = i18n_err.NewI18nError("err_venue_not_found")
+	ErrVenueBlockNotFound = i18n_err.NewI18nError("err_venue_block_not_found")
+	ErrVenueUnavailable   = i18n_err.NewI18nError("err_venue_unavailable")
+
+	// Official
+	ErrOfficialNotFound           = i18n_err.NewI18nError("err_official_not_found")
+	ErrOfficialAssignmentConflict = i18n_err.NewI18nError("err_official_assignment_conflict")
+
 	// Player
 	ErrPlayerNotFound    = i18n_err.NewI18nError("err_player_not_found")
 	ErrJerseyNumberTaken = i18n_err.NewI18nError("err_jersey_number_taken")
+	ErrPlayerNotInMatch  = i18n_err.NewI18nError("err_player_not_in_match")
+
+	// Staff
+	ErrStaffNotFound      = i18n_err.NewI18nError("err_staff_not_found")
+	ErrStaffTenureOverlap = i18n_err.NewI18nError("err_staff_tenure_overlap")
 
 	// Match
 	ErrMatchNotFound         = i18n_err.NewI18nError("err_match_not_found")
 	ErrMatchAlreadyHasResult = i18n_err.NewI18nError("err_match_already_has_result")
 	ErrMatchNotCompleted     = i18n_err.NewI18nError("err_match_not_completed")
 	ErrSameTeamMatch         = i18n_err.NewI18nError("err_same_team_match")
+	ErrMatchNotScheduled     = i18n_err.NewI18nError("err_match_not_scheduled")
+	ErrScheduleConflict      = i18n_err.NewI18nError("err_schedule_conflict")
 )
@@ -0,0 +1,93 @@
+package accounttoken
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+func (r *AccountTokenRepository) Create(ctx context.Context, data *entity.AccountToken) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create account token err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+// GetByTokenHash returns the unused, unexpired token for purpose.
+func (r *AccountTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string, purpose entity.AccountTokenPurpose) (data entity.AccountToken, err error) {
+	stmt, err := r.getStatement(ctx, GetByTokenHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, tokenHash, purpose)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByTokenHash account token err: ", err)
+		return
+	}
+
+	return
+}
+
+// MarkUsed uses up the token. It returns sql.ErrNoRows when the token was
+// already used or has expired.
+func (r *AccountTokenRepository) MarkUsed(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, MarkUsed)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("MarkUsed account token err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// InvalidateByUser uses up every outstanding token of the user for purpose,
+// so only the most recently mailed one works.
+func (r *AccountTokenRepository) InvalidateByUser(ctx context.Context, userID int64, purpose entity.AccountTokenPurpose) error {
+	stmt, err := r.getStatement(ctx, InvalidateByUser)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, userID, purpose); err != nil {
+		logger.GetLogger(ctx).Error("InvalidateByUser account token err: ", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package accounttoken
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, user_id, purpose, token_hash, expires_at, used_at, created_at, updated_at, deleted_at`
+
+	GetByTokenHash = iota + 100
+
+	Insert = iota + 200
+	MarkUsed
+	InvalidateByUser
+)
+
+var (
+	masterQueries = []string{
+		GetByTokenHash: fmt.Sprintf(`SELECT %s FROM account_tokens
+			WHERE token_hash = $1 AND purpose = $2 AND deleted_at IS NULL AND used_at IS NULL AND expires_at > NOW()`, AllFields),
+		MarkUsed: `UPDATE account_tokens SET used_at = NOW(), updated_at = NOW()
+			WHERE id = $1 AND deleted_at IS NULL AND used_at IS NULL AND expires_at > NOW()`,
+		InvalidateByUser: `UPDATE account_tokens SET used_at = NOW(), updated_at = NOW()
+			WHERE user_id = $1 AND purpose = $2 AND deleted_at IS NULL AND used_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO account_tokens (user_id, purpose, token_hash, expires_at, created_at, updated_at)
+		VALUES (:user_id, :purpose, :token_hash, :expires_at, NOW(), NOW()) RETURNING id`,
+	}
+)
+
+type AccountTokenRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitAccountTokenRepository(ctx context.Context, db *sqlx.DB) (*AccountTokenRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &AccountTokenRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *AccountTokenRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *AccountTokenRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
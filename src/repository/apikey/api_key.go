@@ -0,0 +1,122 @@
+package apikey
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+func (r *APIKeyRepository) Create(ctx context.Context, data *entity.APIKey) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create api key err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *APIKeyRepository) Get(ctx context.Context, id int64) (data entity.APIKey, err error) {
+	stmt, err := r.getStatement(ctx, GetById)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Get api key err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *APIKeyRepository) GetList(ctx context.Context) (data []entity.APIKey, err error) {
+	stmt, err := r.getStatement(ctx, GetList)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetList api key err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *APIKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (data entity.APIKey, err error) {
+	stmt, err := r.getStatement(ctx, GetByKeyHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, keyHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByKeyHash api key err: ", err)
+		return
+	}
+
+	return
+}
+
+// TouchLastUsed records that the key was just used. Writes within a minute
+// of the previous one are skipped.
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, TouchLastUsed)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, id); err != nil {
+		logger.GetLogger(ctx).Error("TouchLastUsed api key err: ", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *APIKeyRepository) Delete(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, Delete)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Delete api key err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
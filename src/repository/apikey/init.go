@@ -0,0 +1,107 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, name, key_hash, key_prefix, scopes, allowed_ips, created_by, expires_at, last_used_at, created_at, updated_at, deleted_at`
+
+	GetById = iota + 100
+	GetList
+	GetByKeyHash
+
+	Insert = iota + 200
+	TouchLastUsed
+	Delete
+)
+
+var (
+	masterQueries = []string{
+		GetById: fmt.Sprintf("SELECT %s FROM api_keys WHERE id = $1 AND deleted_at IS NULL", AllFields),
+		GetList: fmt.Sprintf("SELECT %s FROM api_keys WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC", AllFields),
+		GetByKeyHash: fmt.Sprintf(`SELECT %s FROM api_keys
+			WHERE key_hash = $1 AND deleted_at IS NULL AND expires_at > NOW()`, AllFields),
+		// last_used_at is only written once a minute so busy clients do
+		// not update the row on every request.
+		TouchLastUsed: `UPDATE api_keys SET last_used_at = NOW()
+			WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')`,
+		Delete: `UPDATE api_keys SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO api_keys (name, key_hash, key_prefix, scopes, allowed_ips, created_by, expires_at, created_at, updated_at)
+		VALUES (:name, :key_hash, :key_prefix, :scopes, :allowed_ips, :created_by, :expires_at, NOW(), NOW()) RETURNING id`,
+	}
+)
+
+type APIKeyRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitAPIKeyRepository(ctx context.Context, db *sqlx.DB) (*APIKeyRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &APIKeyRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *APIKeyRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *APIKeyRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
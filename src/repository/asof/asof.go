@@ -0,0 +1,24 @@
+// Package asof holds the SQL fragments shared by the repositories that can
+// answer a query as it would have been answered at a past cutoff.
+//
+// The cutoff is bound as a timestamptz. TIMESTAMP columns written with NOW()
+// are compared against it in the session timezone they were written in, so
+// the cutoff is one instant regardless of the database and server timezones.
+package asof
+
+import "fmt"
+
+// Visible keeps the rows of the aliased table that existed at the cutoff in
+// parameter param, including rows soft-deleted since, or only live rows when
+// the cutoff is NULL.
+func Visible(alias string, param int) string {
+	return fmt.Sprintf(`(($%[2]d::timestamptz IS NULL AND %[1]s.deleted_at IS NULL) OR
+				(%[1]s.created_at < $%[2]d::timestamptz AND (%[1]s.deleted_at IS NULL OR %[1]s.deleted_at >= $%[2]d::timestamptz)))`, alias, param)
+}
+
+// Completed keeps the aliased matches that had kicked off and had their
+// result submitted before the cutoff in parameter param, or every match when
+// the cutoff is NULL.
+func Completed(alias string, param int) string {
+	return fmt.Sprintf(`($%[2]d::timestamptz IS NULL OR (%[1]s.completed_at < $%[2]d::timestamptz AND %[1]s.kickoff_at < $%[2]d::timestamptz))`, alias, param)
+}
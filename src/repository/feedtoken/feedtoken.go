@@ -0,0 +1,63 @@
+package feedtoken
+
+import (
+	"context"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+func (r *FeedTokenRepository) Create(ctx context.Context, data *entity.FeedToken) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create feed token err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *FeedTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (data entity.FeedToken, err error) {
+	stmt, err := r.getStatement(ctx, GetByTokenHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, tokenHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByTokenHash feed token err: ", err)
+		return
+	}
+
+	return
+}
+
+// DeleteByUser revokes every active feed token of the user. Having none is
+// not an error.
+func (r *FeedTokenRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	stmt, err := r.getStatement(ctx, DeleteByUser)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, userID); err != nil {
+		logger.GetLogger(ctx).Error("DeleteByUser feed token err: ", err)
+		return err
+	}
+
+	return nil
+}
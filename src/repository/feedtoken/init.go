@@ -0,0 +1,103 @@
+package feedtoken
+
+import (
+	"context"
+	"fmt"
+
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	AllFields = `id, user_id, token_hash, created_at, updated_at, deleted_at`
+
+	GetByTokenHash = iota + 100
+
+	Insert = iota + 200
+	DeleteByUser
+)
+
+var (
+	masterQueries = []string{
+		// Tokens of deleted or disabled users do not open any feed.
+		GetByTokenHash: `SELECT f.id, f.user_id, f.token_hash, f.created_at, f.updated_at, f.deleted_at
+			FROM feed_tokens f
+			JOIN users u ON u.id = f.user_id
+			WHERE f.token_hash = $1 AND f.deleted_at IS NULL
+			AND u.deleted_at IS NULL AND u.disabled_at IS NULL`,
+		DeleteByUser: `UPDATE feed_tokens SET deleted_at = NOW() WHERE user_id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO feed_tokens (user_id, token_hash, created_at, updated_at)
+		VALUES (:user_id, :token_hash, NOW(), NOW()) RETURNING id`,
+	}
+)
+
+type FeedTokenRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitFeedTokenRepository(ctx context.Context, db *sqlx.DB) (*FeedTokenRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &FeedTokenRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *FeedTokenRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *FeedTokenRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"go-test/lib/logger"
 	"go-test/src/entity"
+	"time"
 )
 
 func (r *GoalRepository) Create(ctx context.Context, data *entity.Goal) (id int64, err error) {
@@ -45,6 +46,22 @@ func (r *GoalRepository) GetByMatch(ctx context.Context, matchID int64) (data []
 	return
 }
 
+func (r *GoalRepository) GetHeadToHeadScorers(ctx context.Context, teamID, opponentID int64, limit int, asOf *time.Time) (data []entity.GoalScorerStat, err error) {
+	stmt, err := r.getStatement(ctx, GetHeadToHeadScorers)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, teamID, opponentID, limit, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetHeadToHeadScorers goal err: ", err)
+		return
+	}
+
+	return
+}
+
 func (r *GoalRepository) DeleteByMatch(ctx context.Context, matchID int64) error {
 	stmt, err := r.getStatement(ctx, DeleteByMatch)
 	if err != nil {
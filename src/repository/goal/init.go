@@ -7,12 +7,14 @@ import (
 	"github.com/jmoiron/sqlx"
 	"go-test/lib/atomic"
 	atomicSqlx "go-test/lib/atomic/sqlx"
+	"go-test/src/repository/asof"
 )
 
 const (
-	AllFields = `id, match_id, player_id, goal_minute, created_at, updated_at, deleted_at`
+	AllFields = `id, match_id, player_id, team_id, goal_minute, created_at, updated_at, deleted_at`
 
 	GetByMatch = iota + 100
+	GetHeadToHeadScorers
 
 	Insert = iota + 200
 	DeleteByMatch
@@ -20,13 +22,25 @@ const (
 
 var (
 	masterQueries = []string{
-		GetByMatch:    fmt.Sprintf("SELECT %s FROM goals WHERE match_id = $1 AND deleted_at IS NULL ORDER BY goal_minute ASC", AllFields),
+		GetByMatch: fmt.Sprintf("SELECT %s FROM goals WHERE match_id = $1 AND deleted_at IS NULL ORDER BY goal_minute ASC", AllFields),
+		GetHeadToHeadScorers: fmt.Sprintf(`SELECT g.player_id, p.name AS player_name, g.team_id, COUNT(*) AS goals
+			FROM goals g
+			JOIN matches m ON m.id = g.match_id
+			JOIN players p ON p.id = g.player_id
+			WHERE %s
+			AND %s
+			AND m.status = 'completed'
+			AND %s
+			AND ((m.home_team_id = $1 AND m.away_team_id = $2) OR (m.home_team_id = $2 AND m.away_team_id = $1))
+			GROUP BY g.player_id, p.name, g.team_id
+			ORDER BY goals DESC, p.name ASC
+			LIMIT $3`, asof.Visible("g", 4), asof.Visible("m", 4), asof.Completed("m", 4)),
 		DeleteByMatch: `UPDATE goals SET deleted_at = NOW() WHERE match_id = $1 AND deleted_at IS NULL`,
 	}
 
 	masterNamedQueries = []string{
-		Insert: `INSERT INTO goals (match_id, player_id, goal_minute, created_at, updated_at)
-		VALUES (:match_id, :player_id, :goal_minute, NOW(), NOW()) RETURNING id`,
+		Insert: `INSERT INTO goals (match_id, player_id, team_id, goal_minute, created_at, updated_at)
+		VALUES (:match_id, :player_id, :team_id, :goal_minute, NOW(), NOW()) RETURNING id`,
 	}
 )
 
@@ -0,0 +1,105 @@
+package invitation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, email, role, team_ids, token_hash, invited_by, expires_at, accepted_at, created_at, updated_at, deleted_at`
+
+	GetById = iota + 100
+	GetList
+	GetByTokenHash
+
+	Insert = iota + 200
+	MarkAccepted
+	Delete
+)
+
+var (
+	masterQueries = []string{
+		GetById: fmt.Sprintf("SELECT %s FROM invitations WHERE id = $1 AND deleted_at IS NULL", AllFields),
+		GetList: fmt.Sprintf("SELECT %s FROM invitations WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC", AllFields),
+		GetByTokenHash: fmt.Sprintf(`SELECT %s FROM invitations
+			WHERE token_hash = $1 AND deleted_at IS NULL AND accepted_at IS NULL AND expires_at > NOW()`, AllFields),
+		MarkAccepted: `UPDATE invitations SET accepted_at = NOW(), updated_at = NOW()
+			WHERE id = $1 AND deleted_at IS NULL AND accepted_at IS NULL AND expires_at > NOW()`,
+		Delete: `UPDATE invitations SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO invitations (email, role, team_ids, token_hash, invited_by, expires_at, created_at, updated_at)
+		VALUES (:email, :role, :team_ids, :token_hash, :invited_by, :expires_at, NOW(), NOW()) RETURNING id`,
+	}
+)
+
+type InvitationRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitInvitationRepository(ctx context.Context, db *sqlx.DB) (*InvitationRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &InvitationRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *InvitationRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *InvitationRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
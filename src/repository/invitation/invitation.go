@@ -0,0 +1,133 @@
+package invitation
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+func (r *InvitationRepository) Create(ctx context.Context, data *entity.Invitation) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create invitation err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *InvitationRepository) Get(ctx context.Context, id int64) (data entity.Invitation, err error) {
+	stmt, err := r.getStatement(ctx, GetById)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Get invitation err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *InvitationRepository) GetList(ctx context.Context) (data []entity.Invitation, err error) {
+	stmt, err := r.getStatement(ctx, GetList)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetList invitation err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *InvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (data entity.Invitation, err error) {
+	stmt, err := r.getStatement(ctx, GetByTokenHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, tokenHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByTokenHash invitation err: ", err)
+		return
+	}
+
+	return
+}
+
+// MarkAccepted uses up the invitation. It returns sql.ErrNoRows when the
+// invitation was already accepted, revoked or has expired.
+func (r *InvitationRepository) MarkAccepted(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, MarkAccepted)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("MarkAccepted invitation err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) Delete(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, Delete)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Delete invitation err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
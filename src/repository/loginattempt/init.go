@@ -0,0 +1,109 @@
+package loginattempt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, attempt_key, failures, last_failed_at, locked_until, created_at, updated_at, deleted_at`
+
+	GetByKey = iota + 100
+
+	RecordFailure = iota + 200
+	Lock
+	Forgive
+	Reset
+)
+
+var (
+	masterQueries = []string{
+		GetByKey: fmt.Sprintf(`SELECT %s FROM login_attempts WHERE attempt_key = $1 AND deleted_at IS NULL`, AllFields),
+		// $2 is the time of the failure; failures before $3 are forgotten,
+		// so the count starts again at one.
+		RecordFailure: fmt.Sprintf(`INSERT INTO login_attempts (attempt_key, failures, last_failed_at, created_at, updated_at)
+			VALUES ($1, 1, $2, NOW(), NOW())
+			ON CONFLICT (attempt_key) DO UPDATE SET
+				failures = CASE WHEN login_attempts.last_failed_at < $3 THEN 1 ELSE login_attempts.failures + 1 END,
+				last_failed_at = $2, deleted_at = NULL, updated_at = NOW()
+			RETURNING %s`, AllFields),
+		Lock: `UPDATE login_attempts SET failures = 0, locked_until = $2, updated_at = NOW()
+			WHERE attempt_key = $1 AND deleted_at IS NULL`,
+		Forgive: `UPDATE login_attempts SET failures = GREATEST(failures - 1, 0), updated_at = NOW()
+			WHERE attempt_key = $1 AND deleted_at IS NULL`,
+		Reset: `UPDATE login_attempts SET failures = 0, locked_until = NULL, updated_at = NOW()
+			WHERE attempt_key = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{}
+)
+
+type LoginAttemptRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitLoginAttemptRepository(ctx context.Context, db *sqlx.DB) (*LoginAttemptRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &LoginAttemptRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *LoginAttemptRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *LoginAttemptRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
@@ -0,0 +1,98 @@
+package loginattempt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	"time"
+)
+
+// Get returns the attempts of key, or sql.ErrNoRows when it has none. That is
+// the case for most logins, so it is not logged.
+func (r *LoginAttemptRepository) Get(ctx context.Context, key string) (data entity.LoginAttempt, err error) {
+	stmt, err := r.getStatement(ctx, GetByKey)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, key)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.GetLogger(ctx).Error("Get login attempt err: ", err)
+		return
+	}
+
+	return
+}
+
+// RecordFailure counts a failed login of key and returns the updated count
+// and lock.
+// Failures older than window are not counted.
+func (r *LoginAttemptRepository) RecordFailure(ctx context.Context, key string, window time.Duration) (data entity.LoginAttempt, err error) {
+	stmt, err := r.getStatement(ctx, RecordFailure)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	now := time.Now()
+	err = stmt.GetContext(ctx, &data, key, now, now.Add(-window))
+	if err != nil {
+		logger.GetLogger(ctx).Error("RecordFailure login attempt err: ", err)
+		return
+	}
+
+	return
+}
+
+// Lock rejects logins of key until until and clears its failures.
+func (r *LoginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	stmt, err := r.getStatement(ctx, Lock)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, key, until); err != nil {
+		logger.GetLogger(ctx).Error("Lock login attempt err: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// Forgive takes back one failure of key, e.g. an attempt that was counted
+// when it started but succeeded.
+func (r *LoginAttemptRepository) Forgive(ctx context.Context, key string) error {
+	stmt, err := r.getStatement(ctx, Forgive)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, key); err != nil {
+		logger.GetLogger(ctx).Error("Forgive login attempt err: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// Reset clears the failures and lock of key. A key without failures is left
+// as it is.
+func (r *LoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	stmt, err := r.getStatement(ctx, Reset)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, key); err != nil {
+		logger.GetLogger(ctx).Error("Reset login attempt err: ", err)
+		return err
+	}
+
+	return nil
+}
@@ -3,19 +3,32 @@ package match
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"go-test/lib/atomic"
 	atomicSqlx "go-test/lib/atomic/sqlx"
+	"go-test/src/repository/asof"
 
 	"github.com/jmoiron/sqlx"
 )
 
 const (
-	AllFields = `id, home_team_id, away_team_id, match_date, match_time, home_score, away_score, status, created_at, updated_at, deleted_at`
+	AllFields = `id, home_team_id, away_team_id, match_date, match_time, kickoff_at, timezone, venue_id, home_score, away_score, status, completed_at, sequence, created_at, updated_at, deleted_at`
 
 	GetById = iota + 100
 	GetList
 	GetCompletedByTeam
+	GetTeamStats
+	GetHeadToHead
+	GetHeadToHeadSummary
+	GetCompleted
+	GetCompletedFrom
+	GetCompletedInRange
+	GetScheduledInRange
+	GetCompletedAsOf
+	GetTeamFixturesInRange
+	GetFeed
+	GetVenueFixturesInRange
 
 	Insert = iota + 200
 	Update
@@ -27,29 +40,154 @@ const (
 var (
 	masterQueries = []string{
 		GetById: fmt.Sprintf("SELECT %s FROM matches WHERE id = $1 AND deleted_at IS NULL", AllFields),
-		GetList: fmt.Sprintf("SELECT %s FROM matches WHERE deleted_at IS NULL ORDER BY match_date DESC, match_time DESC", AllFields),
-		GetCompletedByTeam: `SELECT id, home_team_id, away_team_id, home_score, away_score, status
-			FROM matches
+		GetList: fmt.Sprintf("SELECT %s FROM matches WHERE deleted_at IS NULL ORDER BY kickoff_at DESC, id DESC", AllFields),
+		GetCompletedByTeam: fmt.Sprintf(`SELECT m.id, m.home_team_id, m.away_team_id, m.match_date, m.home_score, m.away_score, m.status
+			FROM matches m
+			WHERE %s
+			AND m.status = 'completed'
+			AND %s
+			AND (m.home_team_id = $1 OR m.away_team_id = $1)
+			AND m.match_date <= $2
+			ORDER BY m.kickoff_at ASC, m.id ASC`, asof.Visible("m", 3), asof.Completed("m", 3)),
+		GetTeamStats: fmt.Sprintf(`WITH team_matches AS (
+				SELECT m.id AS match_id, m.match_date,
+				m.home_team_id = $1 AS is_home,
+				CASE WHEN m.home_team_id = $1 THEN m.away_team_id ELSE m.home_team_id END AS opponent_id,
+				CASE WHEN m.home_team_id = $1 THEN m.home_score ELSE m.away_score END AS goals_for,
+				CASE WHEN m.home_team_id = $1 THEN m.away_score ELSE m.home_score END AS goals_against
+				FROM matches m
+				WHERE %s
+				AND m.status = 'completed'
+				AND %s
+				AND (m.home_team_id = $1 OR m.away_team_id = $1)
+				AND ($2::date IS NULL OR m.match_date >= $2::date)
+				AND ($3::date IS NULL OR m.match_date <= $3::date)
+			), totals AS (
+				SELECT COUNT(*) AS played,
+				COUNT(*) FILTER (WHERE is_home AND goals_for > goals_against) AS home_wins,
+				COUNT(*) FILTER (WHERE is_home AND goals_for = goals_against) AS home_draws,
+				COUNT(*) FILTER (WHERE is_home AND goals_for < goals_against) AS home_losses,
+				COUNT(*) FILTER (WHERE NOT is_home AND goals_for > goals_against) AS away_wins,
+				COUNT(*) FILTER (WHERE NOT is_home AND goals_for = goals_against) AS away_draws,
+				COUNT(*) FILTER (WHERE NOT is_home AND goals_for < goals_against) AS away_losses,
+				COALESCE(SUM(goals_for), 0) AS goals_for,
+				COALESCE(SUM(goals_against), 0) AS goals_against,
+				COUNT(*) FILTER (WHERE goals_against = 0) AS clean_sheets,
+				COUNT(*) FILTER (WHERE goals_for = 0) AS failed_to_score
+				FROM team_matches
+			)
+			SELECT totals.*,
+			(SELECT ROUND(AVG(g.goal_minute), 1)::float8 FROM goals g
+				JOIN team_matches tm ON tm.match_id = g.match_id
+				WHERE g.team_id = $1 AND %s) AS average_goal_minute,
+			bw.match_id AS "biggest_win.match_id", bw.match_date AS "biggest_win.match_date",
+			bw.opponent_id AS "biggest_win.opponent_id", bwt.name AS "biggest_win.opponent_name", bwt.logo AS "biggest_win.opponent_logo",
+			bw.goals_for AS "biggest_win.goals_for", bw.goals_against AS "biggest_win.goals_against",
+			bl.match_id AS "biggest_loss.match_id", bl.match_date AS "biggest_loss.match_date",
+			bl.opponent_id AS "biggest_loss.opponent_id", blt.name AS "biggest_loss.opponent_name", blt.logo AS "biggest_loss.opponent_logo",
+			bl.goals_for AS "biggest_loss.goals_for", bl.goals_against AS "biggest_loss.goals_against"
+			FROM totals
+			LEFT JOIN LATERAL (
+				SELECT * FROM team_matches WHERE goals_for > goals_against
+				ORDER BY goals_for - goals_against DESC, goals_for DESC, match_date DESC LIMIT 1
+			) bw ON TRUE
+			LEFT JOIN teams bwt ON bwt.id = bw.opponent_id
+			LEFT JOIN LATERAL (
+				SELECT * FROM team_matches WHERE goals_for < goals_against
+				ORDER BY goals_against - goals_for DESC, goals_against DESC, match_date DESC LIMIT 1
+			) bl ON TRUE
+			LEFT JOIN teams blt ON blt.id = bl.opponent_id`, asof.Visible("m", 4), asof.Completed("m", 4), asof.Visible("g", 4)),
+		GetHeadToHead: fmt.Sprintf(`SELECT %s FROM matches m
+			WHERE %s
+			AND m.status = 'completed'
+			AND %s
+			AND ((m.home_team_id = $1 AND m.away_team_id = $2) OR (m.home_team_id = $2 AND m.away_team_id = $1))
+			AND ($3::date IS NULL OR m.match_date < $3::date)
+			AND m.id <> $5
+			ORDER BY m.kickoff_at DESC, m.id DESC`, AllFields, asof.Visible("m", 4), asof.Completed("m", 4)),
+		GetHeadToHeadSummary: fmt.Sprintf(`SELECT COUNT(*) AS played,
+			COUNT(*) FILTER (WHERE team_goals > opponent_goals) AS team_wins,
+			COUNT(*) FILTER (WHERE team_goals = opponent_goals) AS draws,
+			COUNT(*) FILTER (WHERE team_goals < opponent_goals) AS opponent_wins,
+			COALESCE(SUM(team_goals), 0) AS team_goals,
+			COALESCE(SUM(opponent_goals), 0) AS opponent_goals
+			FROM (
+				SELECT CASE WHEN m.home_team_id = $1 THEN m.home_score ELSE m.away_score END AS team_goals,
+				CASE WHEN m.home_team_id = $1 THEN m.away_score ELSE m.home_score END AS opponent_goals
+				FROM matches m
+				WHERE %s
+				AND m.status = 'completed'
+				AND %s
+				AND ((m.home_team_id = $1 AND m.away_team_id = $2) OR (m.home_team_id = $2 AND m.away_team_id = $1))
+				AND ($3::date IS NULL OR m.match_date < $3::date)
+				AND m.id <> $5
+			) h2h`, asof.Visible("m", 4), asof.Completed("m", 4)),
+		GetCompleted: fmt.Sprintf(`SELECT %s FROM matches
+			WHERE deleted_at IS NULL AND status = 'completed'
+			ORDER BY kickoff_at ASC, id ASC`, AllFields),
+		GetCompletedFrom: fmt.Sprintf(`SELECT %s FROM matches
+			WHERE deleted_at IS NULL AND status = 'completed' AND match_date >= $1
+			ORDER BY kickoff_at ASC, id ASC`, AllFields),
+		GetCompletedInRange: fmt.Sprintf(`SELECT %s FROM matches
+			WHERE deleted_at IS NULL AND status = 'completed'
+			AND ($1::date IS NULL OR match_date >= $1::date)
+			AND ($2::date IS NULL OR match_date <= $2::date)
+			ORDER BY kickoff_at ASC, id ASC`, AllFields),
+		GetScheduledInRange: fmt.Sprintf(`SELECT %s FROM matches
+			WHERE deleted_at IS NULL AND status = 'scheduled'
+			AND ($1::date IS NULL OR match_date >= $1::date)
+			AND ($2::date IS NULL OR match_date <= $2::date)
+			ORDER BY kickoff_at ASC, id ASC`, AllFields),
+		GetCompletedAsOf: fmt.Sprintf(`SELECT %s FROM matches m
+			WHERE %s
+			AND m.status = 'completed'
+			AND %s
+			ORDER BY m.kickoff_at ASC, m.id ASC`, AllFields, asof.Visible("m", 1), asof.Completed("m", 1)),
+		GetTeamFixturesInRange: fmt.Sprintf(`SELECT %s FROM matches
 			WHERE deleted_at IS NULL
-			AND status = 'completed'
-			AND (home_team_id = $1 OR away_team_id = $1)
-			AND match_date <= $2
-			ORDER BY match_date ASC`,
-		Delete:             `UPDATE matches SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+			AND id <> $5
+			AND (home_team_id IN ($1, $2) OR away_team_id IN ($1, $2))
+			AND match_date BETWEEN $3::date AND $4::date
+			ORDER BY kickoff_at ASC, id ASC`, AllFields),
+		GetFeed: fmt.Sprintf(`SELECT %s,
+			ht.name AS home_team_name, awt.name AS away_team_name, ht.city AS home_team_city,
+			v.name AS venue_name, v.city AS venue_city
+			FROM matches m
+			JOIN teams ht ON ht.id = m.home_team_id
+			JOIN teams awt ON awt.id = m.away_team_id
+			LEFT JOIN venues v ON v.id = m.venue_id
+			WHERE ($1::bigint IS NULL OR m.home_team_id = $1 OR m.away_team_id = $1)
+			ORDER BY m.kickoff_at ASC, m.id ASC`, prefixedFields("m")),
+		GetVenueFixturesInRange: fmt.Sprintf(`SELECT %s FROM matches
+			WHERE deleted_at IS NULL
+			AND id <> $4
+			AND venue_id = $1
+			AND match_date BETWEEN $2::date AND $3::date
+			ORDER BY kickoff_at ASC, id ASC`, AllFields),
+		Delete:             `UPDATE matches SET deleted_at = NOW(), sequence = sequence + 1, updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
 		DeleteGoalsByMatch: `UPDATE goals SET deleted_at = NOW() WHERE match_id = $1 AND deleted_at IS NULL`,
 	}
 
 	masterNamedQueries = []string{
-		Insert: `INSERT INTO matches (home_team_id, away_team_id, match_date, match_time, status, created_at, updated_at)
-		VALUES (:home_team_id, :away_team_id, :match_date, :match_time, 'scheduled', NOW(), NOW()) RETURNING id`,
+		Insert: `INSERT INTO matches (home_team_id, away_team_id, match_date, match_time, kickoff_at, timezone, venue_id, status, created_at, updated_at)
+		VALUES (:home_team_id, :away_team_id, :match_date, :match_time, :kickoff_at, :timezone, :venue_id, 'scheduled', NOW(), NOW()) RETURNING id`,
 		Update: `UPDATE matches SET home_team_id = :home_team_id, away_team_id = :away_team_id,
-		match_date = :match_date, match_time = :match_time, updated_at = NOW()
+		match_date = :match_date, match_time = :match_time, kickoff_at = :kickoff_at, timezone = :timezone, venue_id = :venue_id, sequence = sequence + 1, updated_at = NOW()
 		WHERE id = :id AND deleted_at IS NULL`,
 		SetResult: `UPDATE matches SET home_score = :home_score, away_score = :away_score,
-		status = 'completed', updated_at = NOW() WHERE id = :id AND deleted_at IS NULL`,
+		status = 'completed', completed_at = NOW(), sequence = sequence + 1, updated_at = NOW() WHERE id = :id AND deleted_at IS NULL`,
 	}
 )
 
+// prefixedFields qualifies every column of AllFields with alias.
+func prefixedFields(alias string) string {
+	fields := strings.Split(AllFields, ", ")
+	for i, f := range fields {
+		fields[i] = alias + "." + f
+	}
+	return strings.Join(fields, ", ")
+}
+
 type MatchRepository struct {
 	db                *sqlx.DB
 	masterStmts       []*sqlx.Stmt
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"go-test/lib/logger"
 	"go-test/src/entity"
+	"time"
 )
 
 func (r *MatchRepository) Create(ctx context.Context, data *entity.Match) (id int64, err error) {
@@ -62,14 +63,152 @@ func (r *MatchRepository) GetList(ctx context.Context) (data []entity.Match, err
 	return
 }
 
-func (r *MatchRepository) GetCompletedByTeam(ctx context.Context, teamID int64, untilDate string) (data []entity.MatchWinStat, err error) {
+func (r *MatchRepository) GetCompleted(ctx context.Context) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetCompleted)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetCompleted match err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetCompletedFrom returns the completed matches played on or after from, in
+// kickoff order.
+func (r *MatchRepository) GetCompletedFrom(ctx context.Context, from time.Time) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetCompletedFrom)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, from)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetCompletedFrom match err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *MatchRepository) GetCompletedAsOf(ctx context.Context, asOf time.Time) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetCompletedAsOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetCompletedAsOf match err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetTeamFixturesInRange returns the matches of either team between from and
+// to (inclusive), leaving out excludeMatchID.
+func (r *MatchRepository) GetTeamFixturesInRange(ctx context.Context, homeTeamID, awayTeamID int64, from, to time.Time, excludeMatchID int64) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetTeamFixturesInRange)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, homeTeamID, awayTeamID, from, to, excludeMatchID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetTeamFixturesInRange match err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetVenueFixturesInRange returns the live matches at a venue between two
+// dates inclusive, except excludeMatchID.
+func (r *MatchRepository) GetVenueFixturesInRange(ctx context.Context, venueID int64, from, to time.Time, excludeMatchID int64) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetVenueFixturesInRange)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, venueID, from, to, excludeMatchID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetVenueFixturesInRange match err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetFeed returns every match, soft-deleted ones included, optionally limited
+// to the matches of one team.
+func (r *MatchRepository) GetFeed(ctx context.Context, teamID *int64) (data []entity.MatchFeedEntry, err error) {
+	stmt, err := r.getStatement(ctx, GetFeed)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, teamID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetFeed match err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetCompletedInRange returns the completed matches between from and to
+// (inclusive). A nil bound is open.
+func (r *MatchRepository) GetCompletedInRange(ctx context.Context, from, to *time.Time) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetCompletedInRange)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, from, to)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetCompletedInRange match err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *MatchRepository) GetScheduledInRange(ctx context.Context, from, to *time.Time) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetScheduledInRange)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, from, to)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetScheduledInRange match err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *MatchRepository) GetCompletedByTeam(ctx context.Context, teamID int64, untilDate string, asOf *time.Time) (data []entity.MatchWinStat, err error) {
 	stmt, err := r.getStatement(ctx, GetCompletedByTeam)
 	if err != nil {
 		logger.GetLogger(ctx).Error("getStatement err: ", err)
 		return
 	}
 
-	err = stmt.SelectContext(ctx, &data, teamID, untilDate)
+	err = stmt.SelectContext(ctx, &data, teamID, untilDate, asOf)
 	if err != nil {
 		logger.GetLogger(ctx).Error("GetCompletedByTeam err: ", err)
 		return
@@ -78,6 +217,54 @@ func (r *MatchRepository) GetCompletedByTeam(ctx context.Context, teamID int64,
 	return
 }
 
+func (r *MatchRepository) GetTeamStats(ctx context.Context, teamID int64, from, to, asOf *time.Time) (data entity.TeamStat, err error) {
+	stmt, err := r.getStatement(ctx, GetTeamStats)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, teamID, from, to, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetTeamStats err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *MatchRepository) GetHeadToHead(ctx context.Context, teamID, opponentID int64, before, asOf *time.Time, excludeMatchID int64) (data []entity.Match, err error) {
+	stmt, err := r.getStatement(ctx, GetHeadToHead)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, teamID, opponentID, before, asOf, excludeMatchID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetHeadToHead err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *MatchRepository) GetHeadToHeadSummary(ctx context.Context, teamID, opponentID int64, before, asOf *time.Time, excludeMatchID int64) (data entity.HeadToHeadStat, err error) {
+	stmt, err := r.getStatement(ctx, GetHeadToHeadSummary)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, teamID, opponentID, before, asOf, excludeMatchID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetHeadToHeadSummary err: ", err)
+		return
+	}
+
+	return
+}
+
 func (r *MatchRepository) Update(ctx context.Context, data *entity.Match) (err error) {
 	namedStmt, err := r.getNamedStatement(ctx, Update)
 	if err != nil {
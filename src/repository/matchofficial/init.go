@@ -0,0 +1,142 @@
+package matchofficial
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, match_id, official_id, role, created_at, updated_at, deleted_at`
+
+	GetByMatch = iota + 100
+	CountByOfficialInRange
+	GetAssignmentsByOfficial
+
+	Insert = iota + 200
+	DeleteByMatch
+)
+
+var (
+	masterQueries = []string{
+		GetByMatch: fmt.Sprintf(`SELECT %s, o.name AS official_name
+			FROM match_officials mo
+			JOIN officials o ON o.id = mo.official_id
+			WHERE mo.match_id = $1 AND mo.deleted_at IS NULL
+			ORDER BY CASE mo.role
+				WHEN 'referee' THEN 1
+				WHEN 'assistant_referee' THEN 2
+				WHEN 'fourth_official' THEN 3
+				ELSE 4
+			END, mo.id ASC`, prefixedFields("mo")),
+		CountByOfficialInRange: `SELECT COUNT(*)
+			FROM match_officials mo
+			JOIN matches m ON m.id = mo.match_id
+			WHERE mo.deleted_at IS NULL
+			AND m.deleted_at IS NULL
+			AND mo.official_id = $1
+			AND m.match_date BETWEEN $2::date AND $3::date
+			AND m.id <> $4`,
+		GetAssignmentsByOfficial: `SELECT m.id AS match_id, m.match_date, m.kickoff_at, m.timezone, m.status,
+			m.home_team_id, ht.name AS home_team_name, m.away_team_id, awt.name AS away_team_name, mo.role,
+			COUNT(c.id) FILTER (WHERE mo.role = 'referee' AND c.card_type = 'yellow') AS yellow_cards,
+			COUNT(c.id) FILTER (WHERE mo.role = 'referee' AND c.card_type = 'red') AS red_cards
+			FROM match_officials mo
+			JOIN matches m ON m.id = mo.match_id
+			JOIN teams ht ON ht.id = m.home_team_id
+			JOIN teams awt ON awt.id = m.away_team_id
+			LEFT JOIN cards c ON c.match_id = m.id AND c.deleted_at IS NULL
+			WHERE mo.deleted_at IS NULL
+			AND m.deleted_at IS NULL
+			AND mo.official_id = $1
+			AND ($2::date IS NULL OR m.match_date >= $2::date)
+			AND ($3::date IS NULL OR m.match_date <= $3::date)
+			GROUP BY mo.id, m.id, ht.name, awt.name
+			ORDER BY m.kickoff_at ASC, m.id ASC`,
+		DeleteByMatch: `UPDATE match_officials SET deleted_at = NOW() WHERE match_id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO match_officials (match_id, official_id, role, created_at, updated_at)
+		VALUES (:match_id, :official_id, :role, NOW(), NOW()) RETURNING id`,
+	}
+)
+
+// prefixedFields qualifies every column of AllFields with alias.
+func prefixedFields(alias string) string {
+	fields := strings.Split(AllFields, ", ")
+	for i, f := range fields {
+		fields[i] = alias + "." + f
+	}
+	return strings.Join(fields, ", ")
+}
+
+type MatchOfficialRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitMatchOfficialRepository(ctx context.Context, db *sqlx.DB) (*MatchOfficialRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &MatchOfficialRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *MatchOfficialRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *MatchOfficialRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
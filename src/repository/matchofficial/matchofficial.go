@@ -0,0 +1,98 @@
+package matchofficial
+
+import (
+	"context"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	"time"
+)
+
+func (r *MatchOfficialRepository) Create(ctx context.Context, data *entity.MatchOfficial) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create match official err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *MatchOfficialRepository) GetByMatch(ctx context.Context, matchID int64) (data []entity.MatchOfficialDetail, err error) {
+	stmt, err := r.getStatement(ctx, GetByMatch)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, matchID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByMatch match official err: ", err)
+		return
+	}
+
+	return
+}
+
+// CountByOfficialInRange counts the live matches an official is assigned to
+// between two dates inclusive, except excludeMatchID.
+func (r *MatchOfficialRepository) CountByOfficialInRange(ctx context.Context, officialID int64, from, to time.Time, excludeMatchID int64) (count int, err error) {
+	stmt, err := r.getStatement(ctx, CountByOfficialInRange)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &count, officialID, from, to, excludeMatchID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("CountByOfficialInRange match official err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *MatchOfficialRepository) GetAssignmentsByOfficial(ctx context.Context, officialID int64, from, to *time.Time) (data []entity.OfficialAssignment, err error) {
+	stmt, err := r.getStatement(ctx, GetAssignmentsByOfficial)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, officialID, from, to)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetAssignmentsByOfficial match official err: ", err)
+		return
+	}
+
+	return
+}
+
+// DeleteByMatch removes every assignment of the match. Having none is not an
+// error.
+func (r *MatchOfficialRepository) DeleteByMatch(ctx context.Context, matchID int64) error {
+	stmt, err := r.getStatement(ctx, DeleteByMatch)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, matchID); err != nil {
+		logger.GetLogger(ctx).Error("DeleteByMatch match official err: ", err)
+		return err
+	}
+
+	return nil
+}
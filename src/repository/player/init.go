@@ -7,15 +7,20 @@ import (
 	"github.com/jmoiron/sqlx"
 	"go-test/lib/atomic"
 	atomicSqlx "go-test/lib/atomic/sqlx"
+	"go-test/src/repository/asof"
 )
 
 const (
 	AllFields = `id, team_id, name, height, weight, position, jersey_number, created_at, updated_at, deleted_at`
 
-	GetById     = iota + 100
+	GetById = iota + 100
 	GetList
 	GetByTeam
 	CheckJersey
+	GetStatSummary
+	GetStatsByTeam
+	GetStatsBySeason
+	GetLeaderboard
 
 	Insert = iota + 200
 	Update
@@ -29,6 +34,42 @@ var (
 		GetByTeam:   fmt.Sprintf("SELECT %s FROM players WHERE team_id = $1 AND deleted_at IS NULL ORDER BY jersey_number", AllFields),
 		CheckJersey: `SELECT COUNT(*) FROM players WHERE team_id = $1 AND jersey_number = $2 AND deleted_at IS NULL AND id != $3`,
 		Delete:      `UPDATE players SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+
+		GetStatSummary: fmt.Sprintf(`%s SELECT %s FROM player_goals pg`,
+			playerGoalsCTE, statAggregates),
+		GetStatsByTeam: fmt.Sprintf(`%s SELECT pg.team_id, t.name AS team_name, %s FROM player_goals pg
+			JOIN teams t ON t.id = pg.team_id
+			GROUP BY pg.team_id, t.name
+			ORDER BY goals DESC, matches_with_goal DESC`,
+			playerGoalsCTE, statAggregates),
+		GetStatsBySeason: fmt.Sprintf(`%s SELECT EXTRACT(YEAR FROM pg.match_date)::int AS season, %s FROM player_goals pg
+			GROUP BY season
+			ORDER BY season DESC`,
+			playerGoalsCTE, statAggregates),
+		GetLeaderboard: fmt.Sprintf(`WITH player_goals AS (
+				SELECT g.player_id, g.match_id, COUNT(*) AS goals
+				FROM goals g
+				JOIN matches m ON m.id = g.match_id
+				JOIN players p ON p.id = g.player_id
+				WHERE %[4]s AND %[1]s AND %[2]s AND m.status = 'completed' AND %[3]s
+				AND ($1::date IS NULL OR m.match_date >= $1::date)
+				AND ($2::date IS NULL OR m.match_date <= $2::date)
+				GROUP BY g.player_id, g.match_id
+			)
+			SELECT * FROM (
+				SELECT p.id AS player_id, p.name AS player_name, p.team_id, COALESCE(t.name, '') AS team_name, COALESCE(t.logo, '') AS team_logo,
+				COUNT(pg.match_id) AS matches_with_goal,
+				SUM(pg.goals) AS goals
+				FROM player_goals pg
+				JOIN players p ON p.id = pg.player_id
+				LEFT JOIN teams t ON t.id = p.team_id
+				GROUP BY p.id, p.name, p.team_id, t.name, t.logo
+			) ranked
+			ORDER BY CASE $3::text
+				WHEN 'matches_with_goal' THEN matches_with_goal
+				ELSE goals
+			END DESC, goals DESC, player_name ASC
+			LIMIT $4`, asof.Visible("p", 5), asof.Visible("m", 5), asof.Completed("m", 5), asof.Visible("g", 5)),
 	}
 
 	masterNamedQueries = []string{
@@ -40,6 +81,25 @@ var (
 	}
 )
 
+// playerGoalsCTE collects the goals of a player ($1) per completed match and
+// the team the goals were recorded for, within an optional date range ($2,
+// $3) as of an optional cutoff ($4). There is no lineup data, so matches the
+// player took part in without scoring are not known.
+var playerGoalsCTE = fmt.Sprintf(`WITH player_goals AS (
+		SELECT g.match_id, g.team_id, m.match_date, COUNT(*) AS goals
+		FROM goals g
+		JOIN matches m ON m.id = g.match_id
+		WHERE g.player_id = $1 AND %[3]s AND %[1]s AND m.status = 'completed' AND %[2]s
+		AND ($2::date IS NULL OR m.match_date >= $2::date)
+		AND ($3::date IS NULL OR m.match_date <= $3::date)
+		GROUP BY g.match_id, g.team_id, m.match_date
+	)`, asof.Visible("m", 4), asof.Completed("m", 4), asof.Visible("g", 4))
+
+const statAggregates = `COUNT(DISTINCT pg.match_id) AS matches_with_goal,
+	COALESCE(SUM(pg.goals), 0) AS goals,
+	MIN(pg.match_date) AS first_goal_date,
+	MAX(pg.match_date) AS last_goal_date`
+
 type PlayerRepository struct {
 	db                *sqlx.DB
 	masterStmts       []*sqlx.Stmt
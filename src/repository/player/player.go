@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"go-test/lib/logger"
 	"go-test/src/entity"
+	"time"
 )
 
 func (r *PlayerRepository) Create(ctx context.Context, data *entity.Player) (id int64, err error) {
@@ -146,3 +147,67 @@ func (r *PlayerRepository) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (r *PlayerRepository) GetStatSummary(ctx context.Context, playerID int64, from, to, asOf *time.Time) (data entity.PlayerStatSummary, err error) {
+	stmt, err := r.getStatement(ctx, GetStatSummary)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, playerID, from, to, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetStatSummary player err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *PlayerRepository) GetStatsByTeam(ctx context.Context, playerID int64, from, to, asOf *time.Time) (data []entity.PlayerTeamStat, err error) {
+	stmt, err := r.getStatement(ctx, GetStatsByTeam)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, playerID, from, to, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetStatsByTeam player err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *PlayerRepository) GetStatsBySeason(ctx context.Context, playerID int64, from, to, asOf *time.Time) (data []entity.PlayerSeasonStat, err error) {
+	stmt, err := r.getStatement(ctx, GetStatsBySeason)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, playerID, from, to, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetStatsBySeason player err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *PlayerRepository) GetLeaderboard(ctx context.Context, metric string, from, to *time.Time, limit int, asOf *time.Time) (data []entity.PlayerLeaderboardEntry, err error) {
+	stmt, err := r.getStatement(ctx, GetLeaderboard)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, from, to, metric, limit, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetLeaderboard player err: ", err)
+		return
+	}
+
+	return
+}
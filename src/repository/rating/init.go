@@ -0,0 +1,133 @@
+package rating
+
+import (
+	"context"
+	"fmt"
+
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+	"go-test/src/repository/asof"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	AllFields = `id, team_id, opponent_id, match_id, match_date, rating_before, rating_after, delta, created_at, updated_at, deleted_at`
+
+	GetLatestBefore = iota + 100
+	GetHistoryByTeam
+	GetRankings
+
+	Insert = iota + 200
+	DeleteAll
+	DeleteFrom
+	Lock
+)
+
+var (
+	masterQueries = []string{
+		GetLatestBefore: fmt.Sprintf(`SELECT DISTINCT ON (team_id) %s FROM team_ratings
+			WHERE match_date < $1 AND deleted_at IS NULL
+			ORDER BY team_id, match_date DESC, match_id DESC`, AllFields),
+		GetHistoryByTeam: `SELECT r.id, r.team_id, r.opponent_id, r.match_id, r.match_date, r.rating_before, r.rating_after, r.delta,
+			r.created_at, r.updated_at, r.deleted_at, COALESCE(t.name, '') AS opponent_name
+			FROM team_ratings r
+			LEFT JOIN teams t ON t.id = r.opponent_id
+			WHERE r.team_id = $1 AND r.deleted_at IS NULL
+			ORDER BY r.match_date ASC, r.match_id ASC`,
+		GetRankings: fmt.Sprintf(`SELECT t.id AS team_id, t.name AS team_name, t.logo AS team_logo,
+			COALESCE(latest.rating_after, $1) AS rating,
+			COALESCE(latest.delta, 0) AS last_delta,
+			COALESCE(played.matches, 0) AS matches,
+			latest.match_date AS last_match_date
+			FROM teams t
+			LEFT JOIN LATERAL (
+				SELECT r.rating_after, r.delta, r.match_date FROM team_ratings r
+				WHERE r.team_id = t.id AND %[2]s
+				ORDER BY r.match_date DESC, r.match_id DESC
+				LIMIT 1
+			) latest ON TRUE
+			LEFT JOIN LATERAL (
+				SELECT COUNT(*) AS matches FROM team_ratings r
+				WHERE r.team_id = t.id AND %[2]s
+			) played ON TRUE
+			WHERE %[1]s
+			ORDER BY rating DESC, t.name ASC`, asof.Visible("t", 2), asof.Visible("r", 2)),
+		DeleteAll:  `UPDATE team_ratings SET deleted_at = NOW() WHERE deleted_at IS NULL`,
+		DeleteFrom: `UPDATE team_ratings SET deleted_at = NOW() WHERE match_date >= $1 AND deleted_at IS NULL`,
+		// Lock conflicts with itself and with writes but not with reads, so
+		// rating replays run one at a time while rankings stay readable.
+		Lock: `LOCK TABLE team_ratings IN SHARE ROW EXCLUSIVE MODE`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO team_ratings (team_id, opponent_id, match_id, match_date, rating_before, rating_after, delta, created_at, updated_at)
+		VALUES (:team_id, :opponent_id, :match_id, :match_date, :rating_before, :rating_after, :delta, NOW(), NOW()) RETURNING id`,
+	}
+)
+
+type RatingRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitRatingRepository(ctx context.Context, db *sqlx.DB) (*RatingRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &RatingRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *RatingRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *RatingRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
@@ -0,0 +1,132 @@
+package rating
+
+import (
+	"context"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	"time"
+)
+
+func (r *RatingRepository) Create(ctx context.Context, data *entity.TeamRating) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create team rating err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+// GetLatestBefore returns the latest rating of every team from matches played
+// before the given date.
+func (r *RatingRepository) GetLatestBefore(ctx context.Context, before time.Time) (data []entity.TeamRating, err error) {
+	stmt, err := r.getStatement(ctx, GetLatestBefore)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, before)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetLatestBefore rating err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *RatingRepository) GetHistoryByTeam(ctx context.Context, teamID int64) (data []entity.TeamRatingHistory, err error) {
+	stmt, err := r.getStatement(ctx, GetHistoryByTeam)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, teamID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetHistoryByTeam rating err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *RatingRepository) GetRankings(ctx context.Context, initialRating float64, asOf *time.Time) (data []entity.TeamRanking, err error) {
+	stmt, err := r.getStatement(ctx, GetRankings)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, initialRating, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetRankings rating err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *RatingRepository) DeleteAll(ctx context.Context) error {
+	stmt, err := r.getStatement(ctx, DeleteAll)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	_, err = stmt.ExecContext(ctx)
+	if err != nil {
+		logger.GetLogger(ctx).Error("DeleteAll rating err: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFrom discards the ratings of matches played on or after from.
+func (r *RatingRepository) DeleteFrom(ctx context.Context, from time.Time) error {
+	stmt, err := r.getStatement(ctx, DeleteFrom)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	_, err = stmt.ExecContext(ctx, from)
+	if err != nil {
+		logger.GetLogger(ctx).Error("DeleteFrom rating err: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// Lock blocks other rating writes until the transaction ends. It must run in
+// an atomic session.
+func (r *RatingRepository) Lock(ctx context.Context) error {
+	stmt, err := r.getStatement(ctx, Lock)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	_, err = stmt.ExecContext(ctx)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Lock rating err: ", err)
+		return err
+	}
+
+	return nil
+}
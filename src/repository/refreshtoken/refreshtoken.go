@@ -0,0 +1,75 @@
+package refreshtoken
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, data *entity.RefreshToken) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create refresh token err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *RefreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (data entity.RefreshToken, err error) {
+	stmt, err := r.getStatement(ctx, GetByTokenHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, tokenHash)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByTokenHash refresh token err: ", err)
+		return
+	}
+
+	return
+}
+
+// MarkUsed uses up the token. It returns sql.ErrNoRows when the token was
+// already used, so concurrent refreshes with the same token cannot both win.
+func (r *RefreshTokenRepository) MarkUsed(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, MarkUsed)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("MarkUsed refresh token err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package revokedtoken
+
+import (
+	"context"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+// Create revokes the token. Revoking a token twice is not an error.
+func (r *RevokedTokenRepository) Create(ctx context.Context, data *entity.RevokedToken) (err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	_, err = namedStmt.ExecContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create revoked token err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetActive lists the revoked tokens that have not expired yet.
+func (r *RevokedTokenRepository) GetActive(ctx context.Context) (data []entity.RevokedToken, err error) {
+	stmt, err := r.getStatement(ctx, GetActive)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetActive revoked token err: ", err)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,108 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, user_id, revoked_at, access_jti, access_expires_at, expires_at, last_used_at, user_agent, ip_address, created_at, updated_at, deleted_at`
+
+	GetById = iota + 100
+	GetActiveByUser
+
+	Insert = iota + 200
+	Update
+	Revoke
+	RevokeByUser
+)
+
+var (
+	masterQueries = []string{
+		GetById: fmt.Sprintf("SELECT %s FROM sessions WHERE id = $1 AND deleted_at IS NULL", AllFields),
+		GetActiveByUser: fmt.Sprintf(`SELECT %s FROM sessions
+			WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW() AND deleted_at IS NULL
+			ORDER BY last_used_at DESC, id DESC`, AllFields),
+		Revoke: `UPDATE sessions SET revoked_at = NOW(), updated_at = NOW() WHERE id = $1 AND revoked_at IS NULL AND deleted_at IS NULL`,
+		RevokeByUser: fmt.Sprintf(`UPDATE sessions SET revoked_at = NOW(), updated_at = NOW()
+			WHERE user_id = $1 AND revoked_at IS NULL AND deleted_at IS NULL RETURNING %s`, AllFields),
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO sessions (user_id, access_jti, access_expires_at, expires_at, last_used_at, user_agent, ip_address, created_at, updated_at)
+		VALUES (:user_id, :access_jti, :access_expires_at, :expires_at, NOW(), :user_agent, :ip_address, NOW(), NOW()) RETURNING id`,
+		Update: `UPDATE sessions SET access_jti = :access_jti, access_expires_at = :access_expires_at, expires_at = :expires_at,
+		last_used_at = NOW(), user_agent = :user_agent, ip_address = :ip_address, updated_at = NOW()
+		WHERE id = :id AND deleted_at IS NULL`,
+	}
+)
+
+type SessionRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitSessionRepository(ctx context.Context, db *sqlx.DB) (*SessionRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &SessionRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *SessionRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *SessionRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
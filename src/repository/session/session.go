@@ -0,0 +1,137 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+func (r *SessionRepository) Create(ctx context.Context, data *entity.Session) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create session err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *SessionRepository) Get(ctx context.Context, id int64) (data entity.Session, err error) {
+	stmt, err := r.getStatement(ctx, GetById)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Get session err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetActiveByUser lists the sessions of a user that are neither revoked nor
+// expired, most recently used first.
+func (r *SessionRepository) GetActiveByUser(ctx context.Context, userID int64) (data []entity.Session, err error) {
+	stmt, err := r.getStatement(ctx, GetActiveByUser)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, userID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetActiveByUser session err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *SessionRepository) Update(ctx context.Context, data *entity.Session) (err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Update)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	result, err := namedStmt.ExecContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Update session err: ", err)
+		return
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return
+}
+
+// Revoke ends the session. It returns sql.ErrNoRows when the session was
+// already revoked.
+func (r *SessionRepository) Revoke(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, Revoke)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Revoke session err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// RevokeByUser ends every session of a user and returns the sessions it
+// revoked.
+func (r *SessionRepository) RevokeByUser(ctx context.Context, userID int64) (data []entity.Session, err error) {
+	stmt, err := r.getStatement(ctx, RevokeByUser)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, userID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("RevokeByUser session err: ", err)
+		return
+	}
+
+	return
+}
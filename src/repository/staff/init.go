@@ -0,0 +1,120 @@
+package staff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, team_id, name, role, start_date, end_date, created_at, updated_at, deleted_at`
+
+	GetById = iota + 100
+	GetList
+	GetByTeamOnDate
+	GetHistoryByTeam
+	CountOverlapping
+
+	Insert = iota + 200
+	Update
+	Delete
+)
+
+// roleOrder sorts staff with the head coach first.
+const roleOrder = `CASE role WHEN 'head_coach' THEN 1 WHEN 'assistant_coach' THEN 2 WHEN 'goalkeeping_coach' THEN 3 ELSE 4 END`
+
+var (
+	masterQueries = []string{
+		GetById: fmt.Sprintf("SELECT %s FROM team_staff WHERE id = $1 AND deleted_at IS NULL", AllFields),
+		GetList: fmt.Sprintf("SELECT %s FROM team_staff WHERE deleted_at IS NULL ORDER BY team_id, %s, start_date DESC", AllFields, roleOrder),
+		GetByTeamOnDate: fmt.Sprintf(`SELECT %s FROM team_staff
+			WHERE team_id = $1 AND deleted_at IS NULL
+			AND start_date <= $2::date AND (end_date IS NULL OR end_date >= $2::date)
+			ORDER BY %s, start_date`, AllFields, roleOrder),
+		GetHistoryByTeam: fmt.Sprintf(`SELECT %s FROM team_staff
+			WHERE team_id = $1 AND deleted_at IS NULL AND end_date < $2::date
+			ORDER BY end_date DESC, %s`, AllFields, roleOrder),
+		CountOverlapping: `SELECT COUNT(*) FROM team_staff
+			WHERE team_id = $1 AND role = $2 AND deleted_at IS NULL AND id != $5
+			AND start_date <= COALESCE($4::date, 'infinity'::date)
+			AND COALESCE(end_date, 'infinity'::date) >= $3::date`,
+		Delete: `UPDATE team_staff SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO team_staff (team_id, name, role, start_date, end_date, created_at, updated_at)
+		VALUES (:team_id, :name, :role, :start_date, :end_date, NOW(), NOW()) RETURNING id`,
+		Update: `UPDATE team_staff SET team_id = :team_id, name = :name, role = :role, start_date = :start_date,
+		end_date = :end_date, updated_at = NOW()
+		WHERE id = :id AND deleted_at IS NULL`,
+	}
+)
+
+type StaffRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitStaffRepository(ctx context.Context, db *sqlx.DB) (*StaffRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &StaffRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *StaffRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *StaffRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
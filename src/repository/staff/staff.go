@@ -0,0 +1,165 @@
+package staff
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	"time"
+)
+
+func (r *StaffRepository) Create(ctx context.Context, data *entity.StaffMember) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create staff err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *StaffRepository) Get(ctx context.Context, id int64) (data entity.StaffMember, err error) {
+	stmt, err := r.getStatement(ctx, GetById)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Get staff err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *StaffRepository) GetList(ctx context.Context) (data []entity.StaffMember, err error) {
+	stmt, err := r.getStatement(ctx, GetList)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetList staff err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *StaffRepository) Update(ctx context.Context, data *entity.StaffMember) (err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Update)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	result, err := namedStmt.ExecContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Update staff err: ", err)
+		return
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return
+}
+
+func (r *StaffRepository) Delete(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, Delete)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Delete staff err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *StaffRepository) GetByTeamOnDate(ctx context.Context, teamID int64, date time.Time) (data []entity.StaffMember, err error) {
+	stmt, err := r.getStatement(ctx, GetByTeamOnDate)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, teamID, date)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByTeamOnDate staff err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *StaffRepository) GetHistoryByTeam(ctx context.Context, teamID int64, before time.Time) (data []entity.StaffMember, err error) {
+	stmt, err := r.getStatement(ctx, GetHistoryByTeam)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, teamID, before)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetHistoryByTeam staff err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *StaffRepository) HasOverlappingTenure(ctx context.Context, teamID int64, role entity.StaffRole, start time.Time, end *time.Time, excludeID int64) (bool, error) {
+	stmt, err := r.getStatement(ctx, CountOverlapping)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return false, err
+	}
+
+	var count int
+	err = stmt.GetContext(ctx, &count, teamID, role, start, end, excludeID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("HasOverlappingTenure staff err: ", err)
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -10,28 +10,37 @@ import (
 )
 
 const (
-	AllFields = `id, name, logo, year_founded, address, city, created_at, updated_at, deleted_at`
+	AllFields = `id, name, logo, year_founded, address, city, home_venue_id, created_at, updated_at, deleted_at`
 
 	GetById = iota + 100
 	GetList
+	GetByIdAsOf
+	GetListAsOf
 
 	Insert = iota + 200
 	Update
 	Delete
+	ClearHomeVenue
 )
 
 var (
 	masterQueries = []string{
 		GetById: fmt.Sprintf("SELECT %s FROM teams WHERE id = $1 AND deleted_at IS NULL", AllFields),
 		GetList: fmt.Sprintf("SELECT %s FROM teams WHERE deleted_at IS NULL ORDER BY created_at DESC", AllFields),
-		Delete:  `UPDATE teams SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+		GetByIdAsOf: fmt.Sprintf(`SELECT %s FROM teams
+			WHERE id = $1 AND created_at < $2::timestamptz AND (deleted_at IS NULL OR deleted_at >= $2::timestamptz)`, AllFields),
+		GetListAsOf: fmt.Sprintf(`SELECT %s FROM teams
+			WHERE created_at < $1::timestamptz AND (deleted_at IS NULL OR deleted_at >= $1::timestamptz)
+			ORDER BY created_at DESC`, AllFields),
+		Delete:         `UPDATE teams SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+		ClearHomeVenue: `UPDATE teams SET home_venue_id = NULL, updated_at = NOW() WHERE home_venue_id = $1`,
 	}
 
 	masterNamedQueries = []string{
-		Insert: `INSERT INTO teams (name, logo, year_founded, address, city, created_at, updated_at)
-		VALUES (:name, :logo, :year_founded, :address, :city, NOW(), NOW()) RETURNING id`,
+		Insert: `INSERT INTO teams (name, logo, year_founded, address, city, home_venue_id, created_at, updated_at)
+		VALUES (:name, :logo, :year_founded, :address, :city, :home_venue_id, NOW(), NOW()) RETURNING id`,
 		Update: `UPDATE teams SET name = :name, logo = :logo, year_founded = :year_founded,
-		address = :address, city = :city, updated_at = NOW() WHERE id = :id AND deleted_at IS NULL`,
+		address = :address, city = :city, home_venue_id = :home_venue_id, updated_at = NOW() WHERE id = :id AND deleted_at IS NULL`,
 	}
 )
 
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"go-test/lib/logger"
 	"go-test/src/entity"
+	"time"
 )
 
 func (r *TeamRepository) Create(ctx context.Context, data *entity.Team) (id int64, err error) {
@@ -62,6 +63,38 @@ func (r *TeamRepository) GetList(ctx context.Context) (data []entity.Team, err e
 	return
 }
 
+func (r *TeamRepository) GetAsOf(ctx context.Context, id int64, asOf time.Time) (data entity.Team, err error) {
+	stmt, err := r.getStatement(ctx, GetByIdAsOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, id, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetAsOf team err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *TeamRepository) GetListAsOf(ctx context.Context, asOf time.Time) (data []entity.Team, err error) {
+	stmt, err := r.getStatement(ctx, GetListAsOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, asOf)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetListAsOf team err: ", err)
+		return
+	}
+
+	return
+}
+
 func (r *TeamRepository) Update(ctx context.Context, data *entity.Team) (err error) {
 	namedStmt, err := r.getNamedStatement(ctx, Update)
 	if err != nil {
@@ -113,3 +146,19 @@ func (r *TeamRepository) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// ClearHomeVenue unsets the home venue of every team playing at venueID.
+func (r *TeamRepository) ClearHomeVenue(ctx context.Context, venueID int64) error {
+	stmt, err := r.getStatement(ctx, ClearHomeVenue)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	if _, err := stmt.ExecContext(ctx, venueID); err != nil {
+		logger.GetLogger(ctx).Error("ClearHomeVenue team err: ", err)
+		return err
+	}
+
+	return nil
+}
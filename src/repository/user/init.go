@@ -10,7 +10,7 @@ import (
 )
 
 const (
-	AllFields = `id, name, email, password, role, created_at, updated_at, deleted_at`
+	AllFields = `id, name, email, password, role, disabled_at, email_verified_at, created_at, updated_at, deleted_at`
 
 	GetById = iota + 100
 	GetByEmail
@@ -18,6 +18,7 @@ const (
 
 	Insert = iota + 200
 	Update
+	UpdatePassword
 	Delete
 )
 
@@ -27,12 +28,14 @@ var (
 		GetByEmail: fmt.Sprintf("SELECT %s FROM users WHERE email = $1 AND deleted_at IS NULL", AllFields),
 		GetList:    fmt.Sprintf("SELECT %s FROM users WHERE deleted_at IS NULL ORDER BY created_at DESC", AllFields),
 		Delete:     `UPDATE users SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+		UpdatePassword: `UPDATE users SET password = $2, updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
 	}
 
 	masterNamedQueries = []string{
-		Insert: `INSERT INTO users (name, email, password, role, created_at, updated_at)
-		VALUES (:name, :email, :password, :role, NOW(), NOW()) RETURNING id`,
-		Update: `UPDATE users SET name = :name, email = :email, updated_at = NOW()
+		Insert: `INSERT INTO users (name, email, password, role, email_verified_at, created_at, updated_at)
+		VALUES (:name, :email, :password, :role, :email_verified_at, NOW(), NOW()) RETURNING id`,
+		Update: `UPDATE users SET name = :name, email = :email, role = :role, disabled_at = :disabled_at,
+		email_verified_at = :email_verified_at, updated_at = NOW()
 		WHERE id = :id AND deleted_at IS NULL`,
 	}
 )
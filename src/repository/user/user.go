@@ -104,6 +104,32 @@ func (r *UserRepository) Update(ctx context.Context, data *entity.User) (err err
 	return
 }
 
+func (r *UserRepository) UpdatePassword(ctx context.Context, id int64, password string) error {
+	stmt, err := r.getStatement(ctx, UpdatePassword)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id, password)
+	if err != nil {
+		logger.GetLogger(ctx).Error("UpdatePassword user err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 func (r *UserRepository) Delete(ctx context.Context, id int64) error {
 	stmt, err := r.getStatement(ctx, Delete)
 	if err != nil {
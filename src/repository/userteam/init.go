@@ -0,0 +1,104 @@
+package userteam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, user_id, team_id, created_at, updated_at, deleted_at`
+
+	GetTeamIDsByUser = iota + 100
+
+	Insert = iota + 200
+	Delete
+	DeleteByUser
+)
+
+var (
+	masterQueries = []string{
+		GetTeamIDsByUser: `SELECT ut.team_id FROM user_teams ut
+			JOIN teams t ON t.id = ut.team_id AND t.deleted_at IS NULL
+			WHERE ut.user_id = $1 AND ut.deleted_at IS NULL
+			ORDER BY ut.team_id`,
+		Delete:       `UPDATE user_teams SET deleted_at = NOW() WHERE user_id = $1 AND team_id = $2 AND deleted_at IS NULL`,
+		DeleteByUser: `UPDATE user_teams SET deleted_at = NOW() WHERE user_id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO user_teams (user_id, team_id, created_at, updated_at)
+		VALUES (:user_id, :team_id, NOW(), NOW())
+		ON CONFLICT (user_id, team_id) WHERE deleted_at IS NULL DO UPDATE SET updated_at = NOW()
+		RETURNING id`,
+	}
+)
+
+type UserTeamRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitUserTeamRepository(ctx context.Context, db *sqlx.DB) (*UserTeamRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &UserTeamRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *UserTeamRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *UserTeamRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
@@ -0,0 +1,89 @@
+package userteam
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+)
+
+func (r *UserTeamRepository) Create(ctx context.Context, data *entity.UserTeam) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create user team err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *UserTeamRepository) GetTeamIDsByUser(ctx context.Context, userID int64) (data []int64, err error) {
+	stmt, err := r.getStatement(ctx, GetTeamIDsByUser)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, userID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetTeamIDsByUser user team err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *UserTeamRepository) Delete(ctx context.Context, userID, teamID int64) error {
+	stmt, err := r.getStatement(ctx, Delete)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, userID, teamID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Delete user team err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *UserTeamRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	stmt, err := r.getStatement(ctx, DeleteByUser)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	_, err = stmt.ExecContext(ctx, userID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("DeleteByUser user team err: ", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package venue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+)
+
+const (
+	AllFields = `id, name, city, capacity, surface, latitude, longitude, timezone, created_at, updated_at, deleted_at`
+
+	GetById = iota + 100
+	GetList
+
+	Insert = iota + 200
+	Update
+	Delete
+)
+
+var (
+	masterQueries = []string{
+		GetById: fmt.Sprintf("SELECT %s FROM venues WHERE id = $1 AND deleted_at IS NULL", AllFields),
+		GetList: fmt.Sprintf("SELECT %s FROM venues WHERE deleted_at IS NULL ORDER BY name ASC, id ASC", AllFields),
+		Delete:  `UPDATE venues SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO venues (name, city, capacity, surface, latitude, longitude, timezone, created_at, updated_at)
+		VALUES (:name, :city, :capacity, :surface, :latitude, :longitude, :timezone, NOW(), NOW()) RETURNING id`,
+		Update: `UPDATE venues SET name = :name, city = :city, capacity = :capacity, surface = :surface,
+		latitude = :latitude, longitude = :longitude, timezone = :timezone, updated_at = NOW()
+		WHERE id = :id AND deleted_at IS NULL`,
+	}
+)
+
+type VenueRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitVenueRepository(ctx context.Context, db *sqlx.DB) (*VenueRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &VenueRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *VenueRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *VenueRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
@@ -0,0 +1,105 @@
+package venueblock
+
+import (
+	"context"
+	"fmt"
+
+	"go-test/lib/atomic"
+	atomicSqlx "go-test/lib/atomic/sqlx"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	AllFields = `id, venue_id, start_date, end_date, reason, note, created_at, updated_at, deleted_at`
+
+	GetById = iota + 100
+	GetByVenueInRange
+
+	Insert = iota + 200
+	Delete
+)
+
+var (
+	masterQueries = []string{
+		GetById: fmt.Sprintf("SELECT %s FROM venue_blocks WHERE id = $1 AND deleted_at IS NULL", AllFields),
+		GetByVenueInRange: fmt.Sprintf(`SELECT %s FROM venue_blocks
+			WHERE deleted_at IS NULL
+			AND venue_id = $1
+			AND start_date <= $3::date
+			AND end_date >= $2::date
+			ORDER BY start_date ASC, id ASC`, AllFields),
+		Delete: `UPDATE venue_blocks SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+	}
+
+	masterNamedQueries = []string{
+		Insert: `INSERT INTO venue_blocks (venue_id, start_date, end_date, reason, note, created_at, updated_at)
+		VALUES (:venue_id, :start_date, :end_date, :reason, :note, NOW(), NOW()) RETURNING id`,
+	}
+)
+
+type VenueBlockRepository struct {
+	db                *sqlx.DB
+	masterStmts       []*sqlx.Stmt
+	masterNamedStmpts []*sqlx.NamedStmt
+}
+
+func InitVenueBlockRepository(ctx context.Context, db *sqlx.DB) (*VenueBlockRepository, error) {
+	stmpts := make([]*sqlx.Stmt, len(masterQueries))
+	for i, query := range masterQueries {
+		if query != "" {
+			stmt, err := db.PreparexContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare query %d: %w", i, err)
+			}
+			stmpts[i] = stmt
+		}
+	}
+
+	namedStmpts := make([]*sqlx.NamedStmt, len(masterNamedQueries))
+	for i, query := range masterNamedQueries {
+		if query != "" {
+			stmt, err := db.PrepareNamedContext(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("prepare named query %d: %w", i, err)
+			}
+			namedStmpts[i] = stmt
+		}
+	}
+
+	return &VenueBlockRepository{
+		db:                db,
+		masterStmts:       stmpts,
+		masterNamedStmpts: namedStmpts,
+	}, nil
+}
+
+func (r *VenueBlockRepository) getStatement(ctx context.Context, queryId int) (*sqlx.Stmt, error) {
+	var err error
+	var statement *sqlx.Stmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			statement, err = atomicSession.Tx().PreparexContext(ctx, masterQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		statement = r.masterStmts[queryId]
+	}
+	return statement, err
+}
+
+func (r *VenueBlockRepository) getNamedStatement(ctx context.Context, queryId int) (*sqlx.NamedStmt, error) {
+	var err error
+	var namedStmt *sqlx.NamedStmt
+	if atomicSessionCtx, ok := ctx.(*atomic.AtomicSessionContext); ok {
+		if atomicSession, ok := atomicSessionCtx.AtomicSession.(*atomicSqlx.SqlxAtomicSession); ok {
+			namedStmt, err = atomicSession.Tx().PrepareNamedContext(ctx, masterNamedQueries[queryId])
+		} else {
+			err = atomic.InvalidAtomicSessionProvider
+		}
+	} else {
+		namedStmt = r.masterNamedStmpts[queryId]
+	}
+	return namedStmt, err
+}
@@ -0,0 +1,92 @@
+package venueblock
+
+import (
+	"context"
+	"database/sql"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	"time"
+)
+
+func (r *VenueBlockRepository) Create(ctx context.Context, data *entity.VenueBlock) (id int64, err error) {
+	namedStmt, err := r.getNamedStatement(ctx, Insert)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getNamedStatement err: ", err)
+		return
+	}
+
+	rows, err := namedStmt.QueryxContext(ctx, data)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Create venue block err: ", err)
+		return
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			logger.GetLogger(ctx).Error("Scan id err: ", err)
+		}
+	}
+
+	return
+}
+
+func (r *VenueBlockRepository) Get(ctx context.Context, id int64) (data entity.VenueBlock, err error) {
+	stmt, err := r.getStatement(ctx, GetById)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.GetContext(ctx, &data, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Get venue block err: ", err)
+		return
+	}
+
+	return
+}
+
+// GetByVenueInRange returns the blocks of a venue that overlap the dates
+// from to to inclusive.
+func (r *VenueBlockRepository) GetByVenueInRange(ctx context.Context, venueID int64, from, to time.Time) (data []entity.VenueBlock, err error) {
+	stmt, err := r.getStatement(ctx, GetByVenueInRange)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return
+	}
+
+	err = stmt.SelectContext(ctx, &data, venueID, from, to)
+	if err != nil {
+		logger.GetLogger(ctx).Error("GetByVenueInRange venue block err: ", err)
+		return
+	}
+
+	return
+}
+
+func (r *VenueBlockRepository) Delete(ctx context.Context, id int64) error {
+	stmt, err := r.getStatement(ctx, Delete)
+	if err != nil {
+		logger.GetLogger(ctx).Error("getStatement err: ", err)
+		return err
+	}
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Delete venue block err: ", err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger(ctx).Error("RowsAffected err: ", err)
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
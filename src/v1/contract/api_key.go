@@ -0,0 +1,26 @@
+package contract
+
+// CreateAPIKeyRequest creates a key for a machine client. Scopes are the
+// permissions the key is granted; users:manage can not be granted to a key.
+type CreateAPIKeyRequest struct {
+	Name      string   `json:"name" binding:"required,max=100"`
+	Scopes    []string `json:"scopes" binding:"required,min=1,dive,oneof=read teams:write teams:update players:write staff:write matches:write results:write venues:write officials:write schedule:override"`
+	ExpiresAt string   `json:"expires_at" binding:"required,datetime=2006-01-02T15:04:05Z07:00"` // RFC3339
+	// AllowedIPs lists the IPs and CIDR ranges the key may be used from.
+	// Empty allows any address.
+	AllowedIPs []string `json:"allowed_ips"`
+}
+
+type APIKeyResponse struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	KeyPrefix  string   `json:"key_prefix"`
+	Scopes     []string `json:"scopes"`
+	AllowedIPs []string `json:"allowed_ips"`
+	CreatedBy  int64    `json:"created_by"`
+	ExpiresAt  string   `json:"expires_at"`
+	LastUsedAt *string  `json:"last_used_at"`
+	CreatedAt  string   `json:"created_at"`
+	// Key is only returned when the key is created.
+	Key string `json:"key,omitempty"`
+}
@@ -16,8 +16,67 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+// LoginRetryDetails are the details of a rejected login attempt or mail
+// request. RetryAfter is in seconds and is also sent as the Retry-After header.
+type LoginRetryDetails struct {
+	RetryAfter int `json:"retry_after"`
+}
+
+// RegisterResponse is returned instead of tokens: the account can log in once
+// its email is verified.
+type RegisterResponse struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ClientInfo describes the device a session is used from. Language is the
+// locale mails sent for the request are written in.
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+	Language  string
+}
+
+type SessionResponse struct {
+	ID         int64  `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IPAddress  string `json:"ip_address"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+	ExpiresAt  string `json:"expires_at"`
+	// Current is set for the session of the token making the request.
+	Current bool `json:"current"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// AuthResponse carries a short-lived access token and the refresh token that
+// renews it. Every refresh returns a new refresh token; the old one can not be
+// used again.
 type AuthResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	ExpiresAt    string `json:"expires_at"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 func ValidatePassword(password string) error {
@@ -45,3 +104,19 @@ func ValidatePassword(password string) error {
 
 	return nil
 }
+
+// JWKSResponse is the JSON Web Key Set (RFC 7517) of the keys access tokens
+// are verified with.
+type JWKSResponse struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is an RSA public key. N and E are base64url encoded without padding.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
@@ -0,0 +1,11 @@
+package contract
+
+type FeedQuery struct {
+	Token string `form:"token" binding:"required"`
+}
+
+type FeedTokenResponse struct {
+	Token         string `json:"token"`
+	AllMatchesURL string `json:"all_matches_url"`
+	TeamFeedURL   string `json:"team_feed_url"` // replace {id} with the team ID
+}
@@ -0,0 +1,28 @@
+package contract
+
+type DateRangeQuery struct {
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`   // YYYY-MM-DD
+}
+
+type AsOfQuery struct {
+	AsOf string `form:"as_of" binding:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+}
+
+// TeamScope limits writes to the teams a team-scoped caller manages. A nil
+// TeamScope is used for league-wide roles and allows every team.
+type TeamScope struct {
+	TeamIDs []int64
+}
+
+func (s *TeamScope) Allows(teamID int64) bool {
+	if s == nil {
+		return true
+	}
+	for _, id := range s.TeamIDs {
+		if id == teamID {
+			return true
+		}
+	}
+	return false
+}
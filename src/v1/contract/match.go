@@ -1,17 +1,55 @@
 package contract
 
+import "time"
+
+// CreateMatchRequest takes the kickoff either as an RFC3339 kickoff_at, or as
+// the legacy match_date and match_time in the match timezone. The timezone is
+// an IANA name and defaults to the venue timezone, then DEFAULT_TIMEZONE. The
+// venue defaults to the home team's venue; set venue_id for neutral grounds.
 type CreateMatchRequest struct {
 	HomeTeamID int64  `json:"home_team_id" binding:"required"`
 	AwayTeamID int64  `json:"away_team_id" binding:"required"`
-	MatchDate  string `json:"match_date" binding:"required"` // YYYY-MM-DD
-	MatchTime  string `json:"match_time" binding:"required"` // HH:MM
+	KickoffAt  string `json:"kickoff_at" binding:"required_without=MatchDate,omitempty,datetime=2006-01-02T15:04:05Z07:00"` // RFC3339
+	Timezone   string `json:"timezone"`                                                                                     // e.g. Asia/Makassar
+	MatchDate  string `json:"match_date" binding:"required_without=KickoffAt,omitempty,datetime=2006-01-02"`                // YYYY-MM-DD
+	MatchTime  string `json:"match_time" binding:"required_with=MatchDate,omitempty,datetime=15:04"`                        // HH:MM
+	VenueID    int64  `json:"venue_id"`
+	Force      bool   `json:"-"`
 }
 
+// UpdateMatchRequest changes only the fields that are set. Changing timezone
+// alone keeps the local match_date and match_time. Changing the home team
+// moves a match at the old home team's venue to the new one's.
 type UpdateMatchRequest struct {
 	HomeTeamID int64  `json:"home_team_id"`
 	AwayTeamID int64  `json:"away_team_id"`
-	MatchDate  string `json:"match_date"`
-	MatchTime  string `json:"match_time"`
+	KickoffAt  string `json:"kickoff_at" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"` // RFC3339
+	Timezone   string `json:"timezone"`
+	MatchDate  string `json:"match_date" binding:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+	MatchTime  string `json:"match_time" binding:"omitempty,datetime=15:04"`      // HH:MM
+	VenueID    int64  `json:"venue_id"`
+	Force      bool   `json:"-"`
+}
+
+// DisplayTimezoneQuery selects the timezone kickoff_at is rendered in. The
+// X-Timezone header is used when tz is not given.
+type DisplayTimezoneQuery struct {
+	Timezone string `form:"tz"`
+}
+
+// ScheduleOverrideQuery lets an admin save a match despite scheduling
+// conflicts with ?force=true.
+type ScheduleOverrideQuery struct {
+	Force bool `form:"force"`
+}
+
+type ScheduleConflict struct {
+	Type      string `json:"type"` // same_day | insufficient_rest | venue_booked
+	TeamID    int64  `json:"team_id,omitempty"`
+	VenueID   int64  `json:"venue_id,omitempty"`
+	MatchID   int64  `json:"match_id"`
+	MatchDate string `json:"match_date"`
+	RestDays  int    `json:"rest_days"`
 }
 
 type GoalInput struct {
@@ -19,10 +57,17 @@ type GoalInput struct {
 	GoalMinute int   `json:"goal_minute" binding:"required,min=1,max=120"`
 }
 
+type CardInput struct {
+	PlayerID   int64  `json:"player_id" binding:"required"`
+	CardType   string `json:"card_type" binding:"required,oneof=yellow red"`
+	CardMinute int    `json:"card_minute" binding:"required,min=1,max=120"`
+}
+
 type SubmitResultRequest struct {
 	HomeScore int         `json:"home_score" binding:"gte=0"`
 	AwayScore int         `json:"away_score" binding:"gte=0"`
 	Goals     []GoalInput `json:"goals"`
+	Cards     []CardInput `json:"cards" binding:"dive"`
 }
 
 type GoalDetail struct {
@@ -32,18 +77,32 @@ type GoalDetail struct {
 	GoalMinute int    `json:"goal_minute"`
 }
 
+type CardDetail struct {
+	ID         int64  `json:"id"`
+	PlayerID   int64  `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	TeamID     int64  `json:"team_id"`
+	CardType   string `json:"card_type"`
+	CardMinute int    `json:"card_minute"`
+}
+
 type MatchResponse struct {
-	ID        int64      `json:"id"`
-	HomeTeam  TeamBrief  `json:"home_team"`
-	AwayTeam  TeamBrief  `json:"away_team"`
-	MatchDate string     `json:"match_date"`
-	MatchTime string     `json:"match_time"`
-	HomeScore *int       `json:"home_score"`
-	AwayScore *int       `json:"away_score"`
-	Status    string     `json:"status"`
-	Goals     []GoalDetail `json:"goals,omitempty"`
-	CreatedAt string     `json:"created_at"`
-	UpdatedAt string     `json:"updated_at"`
+	ID              int64        `json:"id"`
+	HomeTeam        TeamBrief    `json:"home_team"`
+	AwayTeam        TeamBrief    `json:"away_team"`
+	Venue           *VenueBrief  `json:"venue"`
+	KickoffAt       time.Time    `json:"kickoff_at"`
+	Timezone        string       `json:"timezone"`
+	DisplayTimezone string       `json:"display_timezone"`
+	MatchDate       string       `json:"match_date"`
+	MatchTime       string       `json:"match_time"`
+	HomeScore       *int         `json:"home_score"`
+	AwayScore       *int         `json:"away_score"`
+	Status          string       `json:"status"`
+	Goals           []GoalDetail `json:"goals,omitempty"`
+	Cards           []CardDetail `json:"cards,omitempty"`
+	CreatedAt       string       `json:"created_at"`
+	UpdatedAt       string       `json:"updated_at"`
 }
 
 type TopScorerInfo struct {
@@ -52,17 +111,60 @@ type TopScorerInfo struct {
 	Goals    int    `json:"goals"`
 }
 
+type MatchReportHeadToHead struct {
+	Played       int `json:"played"`
+	HomeTeamWins int `json:"home_team_wins"`
+	AwayTeamWins int `json:"away_team_wins"`
+	Draws        int `json:"draws"`
+}
+
 type MatchReportResponse struct {
-	MatchID           int64          `json:"match_id"`
-	MatchDate         string         `json:"match_date"`
-	MatchTime         string         `json:"match_time"`
-	HomeTeam          TeamBrief      `json:"home_team"`
-	AwayTeam          TeamBrief      `json:"away_team"`
-	HomeScore         int            `json:"home_score"`
-	AwayScore         int            `json:"away_score"`
-	FinalStatus       string         `json:"final_status"`
-	TopScorer         *TopScorerInfo `json:"top_scorer"`
-	HomeTeamTotalWins int            `json:"home_team_total_wins"`
-	AwayTeamTotalWins int            `json:"away_team_total_wins"`
-	Goals             []GoalDetail   `json:"goals"`
+	MatchID           int64                 `json:"match_id"`
+	KickoffAt         time.Time             `json:"kickoff_at"`
+	Timezone          string                `json:"timezone"`
+	DisplayTimezone   string                `json:"display_timezone"`
+	MatchDate         string                `json:"match_date"`
+	MatchTime         string                `json:"match_time"`
+	HomeTeam          TeamBrief             `json:"home_team"`
+	AwayTeam          TeamBrief             `json:"away_team"`
+	Venue             *VenueBrief           `json:"venue"`
+	Coaches           MatchCoaches          `json:"coaches"`
+	HomeScore         int                   `json:"home_score"`
+	AwayScore         int                   `json:"away_score"`
+	FinalStatus       string                `json:"final_status"`
+	TopScorer         *TopScorerInfo        `json:"top_scorer"`
+	HomeTeamTotalWins int                   `json:"home_team_total_wins"`
+	AwayTeamTotalWins int                   `json:"away_team_total_wins"`
+	HeadToHead        MatchReportHeadToHead `json:"head_to_head"`
+	Goals             []GoalDetail          `json:"goals"`
+	Cards             []CardDetail          `json:"cards"`
+}
+
+type MatchPredictionOutcome struct {
+	HomeWin float64 `json:"home_win"`
+	Draw    float64 `json:"draw"`
+	AwayWin float64 `json:"away_win"`
+}
+
+type MatchPredictionGoals struct {
+	Home float64 `json:"home"`
+	Away float64 `json:"away"`
+}
+
+type MatchPredictionScore struct {
+	HomeScore   int     `json:"home_score"`
+	AwayScore   int     `json:"away_score"`
+	Probability float64 `json:"probability"`
+}
+
+type MatchPredictionResponse struct {
+	MatchID         int64                  `json:"match_id"`
+	MatchDate       string                 `json:"match_date"`
+	MatchTime       string                 `json:"match_time"`
+	HomeTeam        TeamBrief              `json:"home_team"`
+	AwayTeam        TeamBrief              `json:"away_team"`
+	ExpectedGoals   MatchPredictionGoals   `json:"expected_goals"`
+	Probabilities   MatchPredictionOutcome `json:"probabilities"`
+	LikelyScores    []MatchPredictionScore `json:"likely_scores"`
+	MatchesAnalysed int                    `json:"matches_analysed"`
 }
@@ -0,0 +1,96 @@
+package contract
+
+import "time"
+
+type CreateOfficialRequest struct {
+	Name     string `json:"name" binding:"required"`
+	Email    string `json:"email" binding:"omitempty,email"`
+	HomeCity string `json:"home_city" binding:"required"`
+}
+
+type UpdateOfficialRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email" binding:"omitempty,email"`
+	HomeCity string `json:"home_city"`
+}
+
+type OfficialResponse struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	HomeCity  string `json:"home_city"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type OfficialBrief struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type OfficialAssignmentInput struct {
+	OfficialID int64  `json:"official_id" binding:"required"`
+	Role       string `json:"role" binding:"required,oneof=referee assistant_referee fourth_official var"`
+}
+
+// AssignMatchOfficialsRequest replaces every official of a match. A match has
+// at most one referee, two assistant referees, one fourth official and one VAR.
+type AssignMatchOfficialsRequest struct {
+	Officials []OfficialAssignmentInput `json:"officials" binding:"dive"`
+	Force     bool                      `json:"-"`
+}
+
+type MatchOfficialResponse struct {
+	ID       int64         `json:"id"`
+	Official OfficialBrief `json:"official"`
+	Role     string        `json:"role"`
+}
+
+type MatchOfficialsResponse struct {
+	MatchID   int64                   `json:"match_id"`
+	Officials []MatchOfficialResponse `json:"officials"`
+}
+
+type OfficialAssignmentConflict struct {
+	Rule          string `json:"rule"` // home_city | weekly_limit
+	OfficialID    int64  `json:"official_id"`
+	TeamID        int64  `json:"team_id,omitempty"`
+	MatchesInWeek int    `json:"matches_in_week,omitempty"`
+	Limit         int    `json:"limit,omitempty"`
+}
+
+type OfficialReportQuery struct {
+	DateRangeQuery
+}
+
+type OfficialReportAssignment struct {
+	MatchID     int64     `json:"match_id"`
+	MatchDate   string    `json:"match_date"`
+	KickoffAt   time.Time `json:"kickoff_at"`
+	Timezone    string    `json:"timezone"`
+	Status      string    `json:"status"`
+	HomeTeam    TeamBrief `json:"home_team"`
+	AwayTeam    TeamBrief `json:"away_team"`
+	Role        string    `json:"role"`
+	YellowCards int       `json:"yellow_cards"`
+	RedCards    int       `json:"red_cards"`
+}
+
+type OfficialReportTotals struct {
+	Matches          int `json:"matches"`
+	Referee          int `json:"referee"`
+	AssistantReferee int `json:"assistant_referee"`
+	FourthOfficial   int `json:"fourth_official"`
+	VAR              int `json:"var"`
+	YellowCards      int `json:"yellow_cards"`
+	RedCards         int `json:"red_cards"`
+}
+
+// OfficialReportResponse counts cards only for matches the official refereed.
+type OfficialReportResponse struct {
+	Official    OfficialBrief              `json:"official"`
+	From        string                     `json:"from,omitempty"`
+	To          string                     `json:"to,omitempty"`
+	Totals      OfficialReportTotals       `json:"totals"`
+	Assignments []OfficialReportAssignment `json:"assignments"`
+}
@@ -29,3 +29,62 @@ type PlayerResponse struct {
 	CreatedAt    string  `json:"created_at"`
 	UpdatedAt    string  `json:"updated_at"`
 }
+
+type PlayerStatsRequest struct {
+	DateRangeQuery
+	AsOfQuery
+}
+
+type PlayerLeaderboardRequest struct {
+	DateRangeQuery
+	AsOfQuery
+	Metric string `form:"metric" binding:"omitempty,oneof=goals matches_with_goal"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type PlayerStatSummary struct {
+	MatchesWithGoal int     `json:"matches_with_goal"`
+	Goals           int     `json:"goals"`
+	FirstGoalDate   *string `json:"first_goal_date"`
+	LastGoalDate    *string `json:"last_goal_date"`
+}
+
+type PlayerTeamStats struct {
+	TeamID   int64  `json:"team_id"`
+	TeamName string `json:"team_name"`
+	PlayerStatSummary
+}
+
+type PlayerSeasonStats struct {
+	Season int `json:"season"`
+	PlayerStatSummary
+}
+
+type PlayerStatsResponse struct {
+	PlayerID int64               `json:"player_id"`
+	Name     string              `json:"name"`
+	TeamID   int64               `json:"team_id"`
+	From     string              `json:"from,omitempty"`
+	To       string              `json:"to,omitempty"`
+	AsOf     string              `json:"as_of,omitempty"`
+	Totals   PlayerStatSummary   `json:"totals"`
+	Teams    []PlayerTeamStats   `json:"teams"`
+	Seasons  []PlayerSeasonStats `json:"seasons"`
+}
+
+type PlayerLeaderboardEntry struct {
+	Rank            int       `json:"rank"`
+	PlayerID        int64     `json:"player_id"`
+	Name            string    `json:"name"`
+	Team            TeamBrief `json:"team"`
+	MatchesWithGoal int       `json:"matches_with_goal"`
+	Goals           int       `json:"goals"`
+}
+
+type PlayerLeaderboardResponse struct {
+	Metric  string                   `json:"metric"`
+	From    string                   `json:"from,omitempty"`
+	To      string                   `json:"to,omitempty"`
+	AsOf    string                   `json:"as_of,omitempty"`
+	Players []PlayerLeaderboardEntry `json:"players"`
+}
@@ -0,0 +1,29 @@
+package contract
+
+type RankingsQuery struct {
+	AsOfQuery
+}
+
+type RankingEntry struct {
+	Rank          int       `json:"rank"`
+	Team          TeamBrief `json:"team"`
+	Rating        float64   `json:"rating"`
+	LastChange    float64   `json:"last_change"`
+	Matches       int       `json:"matches"`
+	LastMatchDate *string   `json:"last_match_date"`
+}
+
+type RatingHistoryEntry struct {
+	MatchID      int64     `json:"match_id"`
+	MatchDate    string    `json:"match_date"`
+	Opponent     TeamBrief `json:"opponent"`
+	RatingBefore float64   `json:"rating_before"`
+	RatingAfter  float64   `json:"rating_after"`
+	Delta        float64   `json:"delta"`
+}
+
+type TeamRatingHistoryResponse struct {
+	Team          TeamBrief            `json:"team"`
+	CurrentRating float64              `json:"current_rating"`
+	History       []RatingHistoryEntry `json:"history"`
+}
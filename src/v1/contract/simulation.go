@@ -0,0 +1,28 @@
+package contract
+
+type SeasonSimulationRequest struct {
+	From            string `json:"from" binding:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+	To              string `json:"to" binding:"omitempty,datetime=2006-01-02"`   // YYYY-MM-DD
+	Iterations      int    `json:"iterations" binding:"omitempty,min=1,max=20000"`
+	Seed            *int64 `json:"seed"`
+	RelegationSpots int    `json:"relegation_spots" binding:"omitempty,min=0"`
+}
+
+type SeasonSimulationTeam struct {
+	Team                  TeamBrief `json:"team"`
+	CurrentPoints         int       `json:"current_points"`
+	ExpectedPoints        float64   `json:"expected_points"`
+	TitleProbability      float64   `json:"title_probability"`
+	RelegationProbability float64   `json:"relegation_probability"`
+	// PositionProbabilities[i] is the probability of finishing in position i+1.
+	PositionProbabilities []float64 `json:"position_probabilities"`
+}
+
+type SeasonSimulationResponse struct {
+	From             *string                `json:"from"`
+	To               *string                `json:"to"`
+	Iterations       int                    `json:"iterations"`
+	Seed             int64                  `json:"seed"`
+	RemainingMatches int                    `json:"remaining_matches"`
+	Teams            []SeasonSimulationTeam `json:"teams"`
+}
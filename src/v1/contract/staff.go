@@ -0,0 +1,42 @@
+package contract
+
+type CreateStaffRequest struct {
+	TeamID    int64  `json:"team_id" binding:"required"`
+	Name      string `json:"name" binding:"required"`
+	Role      string `json:"role" binding:"required,oneof=head_coach assistant_coach goalkeeping_coach physio"`
+	StartDate string `json:"start_date" binding:"required,datetime=2006-01-02"` // YYYY-MM-DD
+	EndDate   string `json:"end_date" binding:"omitempty,datetime=2006-01-02"`  // YYYY-MM-DD, empty while in post
+}
+
+type UpdateStaffRequest struct {
+	TeamID    int64  `json:"team_id"`
+	Name      string `json:"name"`
+	Role      string `json:"role" binding:"omitempty,oneof=head_coach assistant_coach goalkeeping_coach physio"`
+	StartDate string `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	// ClearEndDate removes the end date, marking the tenure as ongoing again.
+	ClearEndDate bool `json:"clear_end_date" binding:"excluded_with=EndDate"`
+}
+
+type StaffResponse struct {
+	ID        int64   `json:"id"`
+	TeamID    int64   `json:"team_id"`
+	Name      string  `json:"name"`
+	Role      string  `json:"role"`
+	StartDate string  `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+type StaffBrief struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// MatchCoaches lists the coaching staff of each team in post on the match date.
+type MatchCoaches struct {
+	HomeTeam []StaffBrief `json:"home_team"`
+	AwayTeam []StaffBrief `json:"away_team"`
+}
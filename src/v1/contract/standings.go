@@ -0,0 +1,39 @@
+package contract
+
+type StandingsQuery struct {
+	AsOfQuery
+}
+
+type StandingEntry struct {
+	Position       int       `json:"position"`
+	Team           TeamBrief `json:"team"`
+	Played         int       `json:"played"`
+	Wins           int       `json:"wins"`
+	Draws          int       `json:"draws"`
+	Losses         int       `json:"losses"`
+	GoalsFor       int       `json:"goals_for"`
+	GoalsAgainst   int       `json:"goals_against"`
+	GoalDifference int       `json:"goal_difference"`
+	Points         int       `json:"points"`
+}
+
+type HypotheticalResult struct {
+	MatchID   int64 `json:"match_id" binding:"required"`
+	HomeScore int   `json:"home_score" binding:"gte=0"`
+	AwayScore int   `json:"away_score" binding:"gte=0"`
+}
+
+type WhatIfStandingsRequest struct {
+	Results []HypotheticalResult `json:"results" binding:"required,min=1,max=500,dive"`
+}
+
+type WhatIfStandingEntry struct {
+	StandingEntry
+	CurrentPosition int `json:"current_position"`
+	PointsGained    int `json:"points_gained"`
+}
+
+type WhatIfStandingsResponse struct {
+	Results []HypotheticalResult  `json:"results"`
+	Table   []WhatIfStandingEntry `json:"table"`
+}
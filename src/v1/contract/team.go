@@ -6,6 +6,7 @@ type CreateTeamRequest struct {
 	YearFounded int    `json:"year_founded" form:"year_founded" binding:"required,min=1800,max=2100"`
 	Address     string `json:"address" form:"address"`
 	City        string `json:"city" form:"city" binding:"required"`
+	HomeVenueID int64  `json:"home_venue_id" form:"home_venue_id"`
 }
 
 type UpdateTeamRequest struct {
@@ -14,17 +15,21 @@ type UpdateTeamRequest struct {
 	YearFounded int    `json:"year_founded" form:"year_founded" binding:"omitempty,min=1800,max=2100"`
 	Address     string `json:"address" form:"address"`
 	City        string `json:"city" form:"city"`
+	HomeVenueID int64  `json:"home_venue_id" form:"home_venue_id"`
 }
 
 type TeamResponse struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Logo        string `json:"logo"`
-	YearFounded int    `json:"year_founded"`
-	Address     string `json:"address"`
-	City        string `json:"city"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
+	ID          int64           `json:"id"`
+	Name        string          `json:"name"`
+	Logo        string          `json:"logo"`
+	YearFounded int             `json:"year_founded"`
+	Address     string          `json:"address"`
+	City        string          `json:"city"`
+	HomeVenueID *int64          `json:"home_venue_id"`
+	Staff       []StaffResponse `json:"staff,omitempty"`
+	Form        *TeamForm       `json:"form,omitempty"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
 }
 
 type TeamBrief struct {
@@ -32,3 +37,115 @@ type TeamBrief struct {
 	Name string `json:"name"`
 	Logo string `json:"logo"`
 }
+
+type TeamStatsRequest struct {
+	DateRangeQuery
+	AsOfQuery
+}
+
+type TeamRecord struct {
+	Played int `json:"played"`
+	Wins   int `json:"wins"`
+	Draws  int `json:"draws"`
+	Losses int `json:"losses"`
+}
+
+type TeamStatMatch struct {
+	MatchID      int64     `json:"match_id"`
+	MatchDate    string    `json:"match_date"`
+	Opponent     TeamBrief `json:"opponent"`
+	GoalsFor     int       `json:"goals_for"`
+	GoalsAgainst int       `json:"goals_against"`
+}
+
+type TeamStatsResponse struct {
+	Team              TeamBrief      `json:"team"`
+	From              string         `json:"from,omitempty"`
+	To                string         `json:"to,omitempty"`
+	AsOf              string         `json:"as_of,omitempty"`
+	Overall           TeamRecord     `json:"overall"`
+	Home              TeamRecord     `json:"home"`
+	Away              TeamRecord     `json:"away"`
+	GoalsFor          int            `json:"goals_for"`
+	GoalsAgainst      int            `json:"goals_against"`
+	GoalDifference    int            `json:"goal_difference"`
+	CleanSheets       int            `json:"clean_sheets"`
+	FailedToScore     int            `json:"failed_to_score"`
+	BiggestWin        *TeamStatMatch `json:"biggest_win"`
+	BiggestLoss       *TeamStatMatch `json:"biggest_loss"`
+	AverageGoalMinute *float64       `json:"average_goal_minute"`
+}
+
+type HeadToHeadSide struct {
+	Team   TeamBrief  `json:"team"`
+	Record TeamRecord `json:"record"`
+	Goals  int        `json:"goals"`
+}
+
+type HeadToHeadMatch struct {
+	MatchID   int64  `json:"match_id"`
+	MatchDate string `json:"match_date"`
+	MatchTime string `json:"match_time"`
+	HomeTeam  int64  `json:"home_team_id"`
+	AwayTeam  int64  `json:"away_team_id"`
+	HomeScore int    `json:"home_score"`
+	AwayScore int    `json:"away_score"`
+	Result    string `json:"result"` // W, D or L from the requested team's point of view
+}
+
+type HeadToHeadScorer struct {
+	PlayerID int64  `json:"player_id"`
+	Name     string `json:"name"`
+	TeamID   *int64 `json:"team_id"` // nil for goals whose team is not known
+	Goals    int    `json:"goals"`
+}
+
+type HeadToHeadRequest struct {
+	AsOfQuery
+}
+
+type HeadToHeadResponse struct {
+	Team        HeadToHeadSide     `json:"team"`
+	Opponent    HeadToHeadSide     `json:"opponent"`
+	AsOf        string             `json:"as_of,omitempty"`
+	Played      int                `json:"played"`
+	LastResults []HeadToHeadMatch  `json:"last_results"`
+	Meetings    []HeadToHeadMatch  `json:"meetings"`
+	TopScorers  []HeadToHeadScorer `json:"top_scorers"`
+}
+
+type TeamQuery struct {
+	Include  string `form:"include" binding:"omitempty,oneof=form"`
+	FormLast int    `form:"form_last" binding:"omitempty,min=1,max=20"`
+}
+
+type TeamFormRequest struct {
+	AsOfQuery
+	Last int `form:"last" binding:"omitempty,min=1,max=20"`
+}
+
+type TeamStreak struct {
+	Count int    `json:"count"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+type TeamStreaks struct {
+	Unbeaten  TeamStreak `json:"unbeaten"`
+	Winning   TeamStreak `json:"winning"`
+	Losing    TeamStreak `json:"losing"`
+	Scoreless TeamStreak `json:"scoreless"`
+}
+
+type TeamForm struct {
+	Form    string      `json:"form"` // oldest first, most recent last
+	Played  int         `json:"played"`
+	Current TeamStreaks `json:"current"`
+	Record  TeamStreaks `json:"record"`
+}
+
+type TeamFormResponse struct {
+	Team TeamBrief `json:"team"`
+	AsOf string    `json:"as_of,omitempty"`
+	TeamForm
+}
@@ -0,0 +1,47 @@
+package contract
+
+type UserResponse struct {
+	ID         int64   `json:"id"`
+	Name       string  `json:"name"`
+	Email      string  `json:"email"`
+	Role       string  `json:"role"`
+	TeamIDs    []int64 `json:"team_ids"`
+	Disabled   bool    `json:"disabled"`
+	DisabledAt *string `json:"disabled_at"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+// ChangeUserRoleRequest sets the role of a user. TeamIDs replaces the teams a
+// team_manager manages and must be empty for other roles.
+type ChangeUserRoleRequest struct {
+	Role    string  `json:"role" binding:"required,oneof=super_admin league_admin team_manager official viewer"`
+	TeamIDs []int64 `json:"team_ids"`
+}
+
+// CreateInvitationRequest invites a new user. TeamIDs is only allowed for
+// team_manager.
+type CreateInvitationRequest struct {
+	Email   string  `json:"email" binding:"required,email"`
+	Role    string  `json:"role" binding:"required,oneof=super_admin league_admin team_manager official viewer"`
+	TeamIDs []int64 `json:"team_ids"`
+}
+
+type InvitationResponse struct {
+	ID         int64   `json:"id"`
+	Email      string  `json:"email"`
+	Role       string  `json:"role"`
+	TeamIDs    []int64 `json:"team_ids"`
+	InvitedBy  int64   `json:"invited_by"`
+	ExpiresAt  string  `json:"expires_at"`
+	AcceptedAt *string `json:"accepted_at"`
+	CreatedAt  string  `json:"created_at"`
+	// Token is only returned when the invitation is created.
+	Token string `json:"token,omitempty"`
+}
+
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+}
@@ -0,0 +1,102 @@
+package contract
+
+import "time"
+
+// CreateVenueRequest takes coordinates in decimal degrees. Timezone is an
+// IANA name and defaults to DEFAULT_TIMEZONE.
+type CreateVenueRequest struct {
+	Name      string   `json:"name" binding:"required"`
+	City      string   `json:"city" binding:"required"`
+	Capacity  int      `json:"capacity" binding:"min=0"`
+	Surface   string   `json:"surface" binding:"omitempty,oneof=grass artificial hybrid"`
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,min=-180,max=180"`
+	Timezone  string   `json:"timezone"`
+}
+
+type UpdateVenueRequest struct {
+	Name      string   `json:"name"`
+	City      string   `json:"city"`
+	Capacity  int      `json:"capacity" binding:"omitempty,min=0"`
+	Surface   string   `json:"surface" binding:"omitempty,oneof=grass artificial hybrid"`
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,min=-180,max=180"`
+	Timezone  string   `json:"timezone"`
+}
+
+type VenueResponse struct {
+	ID        int64    `json:"id"`
+	Name      string   `json:"name"`
+	City      string   `json:"city"`
+	Capacity  int      `json:"capacity"`
+	Surface   string   `json:"surface"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Timezone  string   `json:"timezone"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+}
+
+type VenueBrief struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	City string `json:"city"`
+}
+
+type CreateVenueBlockRequest struct {
+	StartDate string `json:"start_date" binding:"required,datetime=2006-01-02"` // YYYY-MM-DD
+	EndDate   string `json:"end_date" binding:"required,datetime=2006-01-02"`   // YYYY-MM-DD, inclusive
+	Reason    string `json:"reason" binding:"required,oneof=maintenance event shared_tenancy other"`
+	Note      string `json:"note"`
+}
+
+type VenueBlockResponse struct {
+	ID        int64  `json:"id"`
+	VenueID   int64  `json:"venue_id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Reason    string `json:"reason"`
+	Note      string `json:"note"`
+	// ConflictingMatchIDs lists the matches already scheduled at the venue
+	// during a newly created block, which need to be moved.
+	ConflictingMatchIDs []int64 `json:"conflicting_match_ids,omitempty"`
+	CreatedAt           string  `json:"created_at"`
+}
+
+// VenueCalendarQuery defaults to the 90 days from today and spans at most
+// 366 days.
+type VenueCalendarQuery struct {
+	DateRangeQuery
+}
+
+type VenueCalendarMatch struct {
+	ID        int64     `json:"id"`
+	HomeTeam  TeamBrief `json:"home_team"`
+	AwayTeam  TeamBrief `json:"away_team"`
+	KickoffAt time.Time `json:"kickoff_at"`
+	Timezone  string    `json:"timezone"`
+	Status    string    `json:"status"`
+}
+
+type VenueCalendarEntry struct {
+	Type      string              `json:"type"` // match | blocked
+	StartDate string              `json:"start_date"`
+	EndDate   string              `json:"end_date"`
+	Match     *VenueCalendarMatch `json:"match,omitempty"`
+	Block     *VenueBlockResponse `json:"block,omitempty"`
+}
+
+type VenueCalendarResponse struct {
+	Venue   VenueBrief           `json:"venue"`
+	From    string               `json:"from"`
+	To      string               `json:"to"`
+	Entries []VenueCalendarEntry `json:"entries"`
+}
+
+// VenueUnavailableDetails explains why a match cannot be played at a venue on
+// its date. SuggestedDates are the nearest free dates, nearest first.
+type VenueUnavailableDetails struct {
+	VenueID        int64                `json:"venue_id"`
+	Blocks         []VenueBlockResponse `json:"blocks"`
+	SuggestedDates []string             `json:"suggested_dates"`
+}
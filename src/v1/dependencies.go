@@ -2,17 +2,36 @@ package v1
 
 import (
 	"context"
+	"errors"
 
 	"go-test/lib/atomic"
 	atomicSQLX "go-test/lib/atomic/sqlx"
+	"go-test/lib/mailer"
 	ginmiddleware "go-test/lib/middleware/gin"
 	"go-test/lib/provider"
 	"go-test/src/app"
+	"go-test/src/entity"
+	accountTokenRepo "go-test/src/repository/accounttoken"
+	apiKeyRepo "go-test/src/repository/apikey"
+	cardRepo "go-test/src/repository/card"
+	feedTokenRepo "go-test/src/repository/feedtoken"
 	goalRepo "go-test/src/repository/goal"
+	invitationRepo "go-test/src/repository/invitation"
+	loginAttemptRepo "go-test/src/repository/loginattempt"
 	matchRepo "go-test/src/repository/match"
+	matchOfficialRepo "go-test/src/repository/matchofficial"
+	officialRepo "go-test/src/repository/official"
 	playerRepo "go-test/src/repository/player"
+	ratingRepo "go-test/src/repository/rating"
+	refreshTokenRepo "go-test/src/repository/refreshtoken"
+	revokedTokenRepo "go-test/src/repository/revokedtoken"
+	sessionRepo "go-test/src/repository/session"
+	staffRepo "go-test/src/repository/staff"
 	teamRepo "go-test/src/repository/team"
 	userRepo "go-test/src/repository/user"
+	userTeamRepo "go-test/src/repository/userteam"
+	venueRepo "go-test/src/repository/venue"
+	venueBlockRepo "go-test/src/repository/venueblock"
 	"go-test/src/v1/service"
 
 	"github.com/sirupsen/logrus"
@@ -21,17 +40,46 @@ import (
 type APIRepositories struct {
 	AtomicSessionProvider atomic.AtomicSessionProvider
 	UserRepo              *userRepo.UserRepository
+	UserTeamRepo          *userTeamRepo.UserTeamRepository
+	InvitationRepo        *invitationRepo.InvitationRepository
+	SessionRepo           *sessionRepo.SessionRepository
+	RefreshTokenRepo      *refreshTokenRepo.RefreshTokenRepository
+	RevokedTokenRepo      *revokedTokenRepo.RevokedTokenRepository
+	APIKeyRepo            *apiKeyRepo.APIKeyRepository
+	AccountTokenRepo      *accountTokenRepo.AccountTokenRepository
+	LoginAttemptRepo      *loginAttemptRepo.LoginAttemptRepository
 	TeamRepo              *teamRepo.TeamRepository
 	PlayerRepo            *playerRepo.PlayerRepository
 	MatchRepo             *matchRepo.MatchRepository
 	GoalRepo              *goalRepo.GoalRepository
+	RatingRepo            *ratingRepo.RatingRepository
+	FeedTokenRepo         *feedTokenRepo.FeedTokenRepository
+	VenueRepo             *venueRepo.VenueRepository
+	VenueBlockRepo        *venueBlockRepo.VenueBlockRepository
+	CardRepo              *cardRepo.CardRepository
+	OfficialRepo          *officialRepo.OfficialRepository
+	MatchOfficialRepo     *matchOfficialRepo.MatchOfficialRepository
+	StaffRepo             *staffRepo.StaffRepository
 }
 
 type APIServices struct {
-	AuthService   *service.AuthService
-	TeamService   *service.TeamService
-	PlayerService *service.PlayerService
-	MatchService  *service.MatchService
+	KeyStore          *provider.KeyStore
+	RevocationStore   *service.TokenRevocationStore
+	KeyService        *service.KeyService
+	AuthService       *service.AuthService
+	AccountService    *service.AccountService
+	UserService       *service.UserService
+	APIKeyService     *service.APIKeyService
+	TeamService       *service.TeamService
+	PlayerService     *service.PlayerService
+	MatchService      *service.MatchService
+	RatingService     *service.RatingService
+	SimulationService *service.SimulationService
+	StandingsService  *service.StandingsService
+	CalendarService   *service.CalendarService
+	VenueService      *service.VenueService
+	OfficialService   *service.OfficialService
+	StaffService      *service.StaffService
 }
 
 type APIDepedencies struct {
@@ -51,6 +99,46 @@ func instantiateAPIRepositories(ctx context.Context) *APIRepositories {
 		logrus.WithContext(ctx).Fatal("init user repo err: ", err)
 	}
 
+	r.UserTeamRepo, err = userTeamRepo.InitUserTeamRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init user team repo err: ", err)
+	}
+
+	r.InvitationRepo, err = invitationRepo.InitInvitationRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init invitation repo err: ", err)
+	}
+
+	r.SessionRepo, err = sessionRepo.InitSessionRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init session repo err: ", err)
+	}
+
+	r.RefreshTokenRepo, err = refreshTokenRepo.InitRefreshTokenRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init refresh token repo err: ", err)
+	}
+
+	r.RevokedTokenRepo, err = revokedTokenRepo.InitRevokedTokenRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init revoked token repo err: ", err)
+	}
+
+	r.APIKeyRepo, err = apiKeyRepo.InitAPIKeyRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init api key repo err: ", err)
+	}
+
+	r.AccountTokenRepo, err = accountTokenRepo.InitAccountTokenRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init account token repo err: ", err)
+	}
+
+	r.LoginAttemptRepo, err = loginAttemptRepo.InitLoginAttemptRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init login attempt repo err: ", err)
+	}
+
 	r.TeamRepo, err = teamRepo.InitTeamRepository(ctx, app.DB())
 	if err != nil {
 		logrus.WithContext(ctx).Fatal("init team repo err: ", err)
@@ -71,30 +159,176 @@ func instantiateAPIRepositories(ctx context.Context) *APIRepositories {
 		logrus.WithContext(ctx).Fatal("init goal repo err: ", err)
 	}
 
+	r.RatingRepo, err = ratingRepo.InitRatingRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init rating repo err: ", err)
+	}
+
+	r.FeedTokenRepo, err = feedTokenRepo.InitFeedTokenRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init feed token repo err: ", err)
+	}
+
+	r.VenueRepo, err = venueRepo.InitVenueRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init venue repo err: ", err)
+	}
+
+	r.VenueBlockRepo, err = venueBlockRepo.InitVenueBlockRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init venue block repo err: ", err)
+	}
+
+	r.CardRepo, err = cardRepo.InitCardRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init card repo err: ", err)
+	}
+
+	r.OfficialRepo, err = officialRepo.InitOfficialRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init official repo err: ", err)
+	}
+
+	r.MatchOfficialRepo, err = matchOfficialRepo.InitMatchOfficialRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init match official repo err: ", err)
+	}
+
+	r.StaffRepo, err = staffRepo.InitStaffRepository(ctx, app.DB())
+	if err != nil {
+		logrus.WithContext(ctx).Fatal("init staff repo err: ", err)
+	}
+
 	return &r
 }
 
 func instantiateAPIServices(ctx context.Context, r *APIRepositories) *APIServices {
 	cfg := app.Config().JWT
 
-	privateKey, err := provider.LoadRSAPrivateKey(cfg.PrivateKeyPath)
+	keyStore, err := provider.NewKeyStore(cfg.KeysDir, cfg.KeysReloadInterval)
+	if errors.Is(err, provider.ErrNoSigningKey) {
+		logrus.WithContext(ctx).Fatalf("load JWT keyring: %v in %s, run `make keys.generate` to create one", err, cfg.KeysDir)
+	}
 	if err != nil {
-		logrus.WithContext(ctx).Fatalf("load RSA private key: %v", err)
+		logrus.WithContext(ctx).Fatalf("load JWT keyring: %v", err)
 	}
 
 	pswdProvider := &provider.Bcrypt{}
 	pswdComparator := &provider.Bcrypt{}
 
+	eloCfg := app.Config().Elo
+	ratingService := service.NewRatingService(
+		r.RatingRepo,
+		r.TeamRepo,
+		r.MatchRepo,
+		r.AtomicSessionProvider,
+		service.RatingConfig{
+			InitialRating:      eloCfg.InitialRating,
+			KFactor:            eloCfg.KFactor,
+			HomeAdvantage:      eloCfg.HomeAdvantage,
+			GoalDiffMultiplier: eloCfg.GoalDiffMultiplier,
+		},
+	)
+
+	var mail mailer.Mailer
+	mailCfg := app.Config().Mail
+	if mailCfg.Driver == "smtp" {
+		mail, err = mailer.NewSMTPMailer(mailCfg.SMTPHost, mailCfg.SMTPPort, mailCfg.SMTPUsername, mailCfg.SMTPPassword, mailCfg.From)
+		if err != nil {
+			logrus.WithContext(ctx).Fatalf("init SMTP mailer: %v", err)
+		}
+	} else {
+		mail = mailer.NewFileMailer(mailCfg.FileDir, mailCfg.From)
+	}
+
+	revocationStore := service.NewTokenRevocationStore(
+		r.RevokedTokenRepo,
+		service.RevocationConfig{SyncInterval: app.Config().Auth.RevocationSyncInterval},
+	)
+
+	authCfg := app.Config().Auth
+	var loginAttempts service.LoginAttemptStore = r.LoginAttemptRepo
+	if authCfg.LoginAttemptStore == "memory" {
+		loginAttempts = service.NewMemoryLoginAttemptStore()
+	}
+	loginThrottle := service.NewLoginThrottle(loginAttempts, service.LoginThrottleConfig{
+		MaxFailures:   authCfg.LoginMaxFailures,
+		IPMaxFailures: authCfg.LoginIPMaxFailures,
+		FailureWindow: authCfg.LoginFailureWindow,
+		Lockout:       authCfg.LoginLockout,
+		Delay:         authCfg.LoginDelay,
+		MailCooldown:  authCfg.MailCooldown,
+	})
+
+	accountService := service.NewAccountService(
+		r.UserRepo,
+		r.AccountTokenRepo,
+		r.SessionRepo,
+		r.FeedTokenRepo,
+		revocationStore,
+		loginThrottle,
+		loginThrottle,
+		r.AtomicSessionProvider,
+		pswdProvider,
+		&provider.RandomToken{},
+		mail,
+		service.AccountConfig{
+			PasswordResetTTL:     app.Config().Auth.PasswordResetTTL,
+			EmailVerificationTTL: app.Config().Auth.EmailVerificationTTL,
+			LinkBaseURL:          mailCfg.LinkBaseURL,
+		},
+	)
+
 	return &APIServices{
+		KeyStore:        keyStore,
+		RevocationStore: revocationStore,
+		KeyService:      service.NewKeyService(keyStore),
 		AuthService: service.NewAuthService(
 			r.UserRepo,
+			r.UserTeamRepo,
+			r.InvitationRepo,
+			r.SessionRepo,
+			r.RefreshTokenRepo,
+			revocationStore,
 			r.AtomicSessionProvider,
-			privateKey,
+			keyStore,
 			pswdProvider,
 			pswdComparator,
+			&provider.RandomToken{},
+			&provider.GoogleUUID{},
+			accountService,
+			loginThrottle,
+			service.AuthConfig{
+				OpenRegistration: app.Config().Auth.OpenRegistration,
+				AccessTokenTTL:   app.Config().Auth.AccessTokenTTL,
+				RefreshTokenTTL:  app.Config().Auth.RefreshTokenTTL,
+			},
+		),
+		AccountService: accountService,
+		UserService: service.NewUserService(
+			r.UserRepo,
+			r.UserTeamRepo,
+			r.TeamRepo,
+			r.InvitationRepo,
+			r.SessionRepo,
+			r.FeedTokenRepo,
+			revocationStore,
+			loginThrottle,
+			&provider.RandomToken{},
+			r.AtomicSessionProvider,
+			service.UserConfig{InvitationTTL: app.Config().Auth.InvitationTTL},
+		),
+		APIKeyService: service.NewAPIKeyService(
+			r.APIKeyRepo,
+			&provider.RandomToken{},
+			r.AtomicSessionProvider,
 		),
 		TeamService: service.NewTeamService(
 			r.TeamRepo,
+			r.MatchRepo,
+			r.GoalRepo,
+			r.VenueRepo,
+			r.StaffRepo,
 			r.AtomicSessionProvider,
 		),
 		PlayerService: service.NewPlayerService(
@@ -102,27 +336,69 @@ func instantiateAPIServices(ctx context.Context, r *APIRepositories) *APIService
 			r.TeamRepo,
 			r.AtomicSessionProvider,
 		),
+		StaffService: service.NewStaffService(
+			r.StaffRepo,
+			r.TeamRepo,
+			r.AtomicSessionProvider,
+		),
 		MatchService: service.NewMatchService(
 			r.MatchRepo,
 			r.TeamRepo,
 			r.PlayerRepo,
 			r.GoalRepo,
+			r.CardRepo,
+			r.StaffRepo,
+			r.VenueRepo,
+			r.VenueBlockRepo,
+			ratingService,
 			r.AtomicSessionProvider,
+			service.ScheduleConfig{
+				MinRestDays:     app.Config().Schedule.MinRestDays,
+				DefaultTimezone: app.Config().DefaultTimezone,
+			},
+		),
+		RatingService:     ratingService,
+		SimulationService: service.NewSimulationService(r.TeamRepo, r.MatchRepo),
+		StandingsService:  service.NewStandingsService(r.TeamRepo, r.MatchRepo),
+		CalendarService: service.NewCalendarService(
+			r.FeedTokenRepo,
+			r.MatchRepo,
+			r.TeamRepo,
+			&provider.RandomToken{},
+			r.AtomicSessionProvider,
+			service.CalendarConfig{ServiceName: app.Config().ServiceName},
+		),
+		VenueService: service.NewVenueService(
+			r.VenueRepo,
+			r.VenueBlockRepo,
+			r.TeamRepo,
+			r.MatchRepo,
+			r.AtomicSessionProvider,
+			service.VenueConfig{DefaultTimezone: app.Config().DefaultTimezone},
+		),
+		OfficialService: service.NewOfficialService(
+			r.OfficialRepo,
+			r.MatchOfficialRepo,
+			r.MatchRepo,
+			r.TeamRepo,
+			r.AtomicSessionProvider,
+			service.OfficialConfig{
+				MaxMatchesPerWeek: app.Config().Officials.MaxMatchesPerWeek,
+				AllowHomeCity:     app.Config().Officials.AllowHomeCity,
+			},
 		),
 	}
 }
 
 func Dependencies(ctx context.Context) *APIDepedencies {
-	cfg := app.Config().JWT
-
-	publicKey, err := provider.LoadRSAPublicKey(cfg.PublicKeyPath)
-	if err != nil {
-		logrus.WithContext(ctx).Fatalf("load RSA public key: %v", err)
-	}
-
 	repositories := instantiateAPIRepositories(ctx)
 	services := instantiateAPIServices(ctx, repositories)
-	jwtMiddleware := ginmiddleware.NewGinJWTMiddleware(publicKey)
+	jwtMiddleware := ginmiddleware.NewGinJWTMiddleware(
+		services.KeyStore.PublicKey,
+		entity.Authorize,
+		services.RevocationStore.IsRevoked,
+		services.APIKeyService.Authenticate,
+	)
 
 	return &APIDepedencies{
 		Repositories:  repositories,
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go-test/lib/i18n"
+	i18n_err "go-test/lib/i18n/errors"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// ForgotPasswordHandler godoc
+//
+// @Summary		Forgot password
+// @Description	Mail a single-use password reset link in the caller's language. Always succeeds so it does not reveal whether the email is registered. One email can request a link once per cooldown; a 429 response carries a Retry-After header
+// @Tags		auth
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.ForgotPasswordRequest	true	"forgot password request"
+// @Success		200		{object}	ginmiddleware.Response
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		429		{object}	ginmiddleware.Response
+// @Router		/v1/auth/password/forgot [post]
+func ForgotPasswordHandler(svc AccountService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.ForgotPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.ForgotPassword(ctx, req, i18n.GetSupportedLocale(ginmiddleware.GetLanguage(c))); err != nil {
+			if details, ok := i18n_err.Details(err).(contract.LoginRetryDetails); ok {
+				c.Header("Retry-After", strconv.Itoa(details.RetryAfter))
+			}
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// ResetPasswordHandler godoc
+//
+// @Summary		Reset password
+// @Description	Set a new password with the token from a reset link. Signs the user out of every session
+// @Tags		auth
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.ResetPasswordRequest	true	"reset password request"
+// @Success		200		{object}	ginmiddleware.Response
+// @Failure		400		{object}	ginmiddleware.Response
+// @Router		/v1/auth/password/reset [post]
+func ResetPasswordHandler(svc AccountService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.ResetPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := contract.ValidatePassword(req.Password); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.ResetPassword(ctx, req); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// VerifyEmailHandler godoc
+//
+// @Summary		Verify email
+// @Description	Verify the email of a registered account with the token from a verification link
+// @Tags		auth
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.VerifyEmailRequest	true	"verify email request"
+// @Success		200		{object}	ginmiddleware.Response
+// @Failure		400		{object}	ginmiddleware.Response
+// @Router		/v1/auth/email/verify [post]
+func VerifyEmailHandler(svc AccountService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.VerifyEmailRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.VerifyEmail(ctx, req); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// ResendVerificationHandler godoc
+//
+// @Summary		Resend verification email
+// @Description	Mail a new verification link to an unverified account. Always succeeds so it does not reveal whether the email is registered. One email can request a link once per cooldown; a 429 response carries a Retry-After header
+// @Tags		auth
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.ResendVerificationRequest	true	"resend verification request"
+// @Success		200		{object}	ginmiddleware.Response
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		429		{object}	ginmiddleware.Response
+// @Router		/v1/auth/email/resend [post]
+func ResendVerificationHandler(svc AccountService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.ResendVerificationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.ResendVerification(ctx, req, i18n.GetSupportedLocale(ginmiddleware.GetLanguage(c))); err != nil {
+			if details, ok := i18n_err.Details(err).(contract.LoginRetryDetails); ok {
+				c.Header("Retry-After", strconv.Itoa(details.RetryAfter))
+			}
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
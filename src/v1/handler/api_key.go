@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// CreateAPIKeyHandler godoc
+//
+// @Summary		Create API key
+// @Description	Create a key for a machine client, sent in the X-API-Key header instead of a JWT. The key is only returned in this response
+// @Tags		api-keys
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.CreateAPIKeyRequest	true	"create API key request"
+// @Success		201		{object}	ginmiddleware.Response{data=contract.APIKeyResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/api-keys [post]
+func CreateAPIKeyHandler(svc APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		actorID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		var req contract.CreateAPIKeyRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.CreateAPIKey(ctx, actorID, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINCreatedResponse(c, resp)
+	}
+}
+
+// GetAPIKeysHandler godoc
+//
+// @Summary		Get all API keys
+// @Description	Get list of all API keys that have not been revoked, newest first
+// @Tags		api-keys
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.APIKeyResponse}
+// @Failure		403	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/api-keys [get]
+func GetAPIKeysHandler(svc APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		resp, err := svc.GetAPIKeys(ctx)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// DeleteAPIKeyHandler godoc
+//
+// @Summary		Revoke API key
+// @Description	Revoke an API key so it can no longer be used
+// @Tags		api-keys
+// @Produce		json
+// @Param		id	path		int	true	"API key ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/api-keys/{id} [delete]
+func DeleteAPIKeyHandler(svc APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.DeleteAPIKey(ctx, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
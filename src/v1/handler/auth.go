@@ -1,7 +1,12 @@
 package handler
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/gin-gonic/gin"
+	"go-test/lib/i18n"
+	i18n_err "go-test/lib/i18n/errors"
 	ginmiddleware "go-test/lib/middleware/gin"
 	apperrors "go-test/src/errors"
 	"go-test/src/v1/contract"
@@ -10,13 +15,14 @@ import (
 // RegisterHandler godoc
 //
 // @Summary		Register user
-// @Description	Register a new user account
+// @Description	Register a new viewer account and mail it an email verification link. The account can log in once verified. Only available when AUTH_OPEN_REGISTRATION is enabled
 // @Tags		auth
 // @Accept		json
 // @Produce		json
 // @Param		body	body		contract.RegisterRequest	true	"register request"
-// @Success		201		{object}	ginmiddleware.Response{data=contract.AuthResponse}
+// @Success		201		{object}	ginmiddleware.Response{data=contract.RegisterResponse}
 // @Failure		400		{object}	ginmiddleware.Response
+// @Failure		403		{object}	ginmiddleware.Response
 // @Failure		409		{object}	ginmiddleware.Response
 // @Router		/v1/auth/register [post]
 func RegisterHandler(svc AuthService) gin.HandlerFunc {
@@ -34,10 +40,10 @@ func RegisterHandler(svc AuthService) gin.HandlerFunc {
 			return
 		}
 
-		authResp, err := svc.Register(ctx, req)
+		resp, err := svc.Register(ctx, req, clientInfo(c))
 		if err != nil {
 			switch err {
-			case apperrors.ErrEmailAlreadyExists:
+			case apperrors.ErrEmailAlreadyExists, apperrors.ErrRegistrationClosed:
 				ginmiddleware.GINErrorResponse(c, err)
 			default:
 				ginmiddleware.GINBadRequestResponse(c)
@@ -45,20 +51,22 @@ func RegisterHandler(svc AuthService) gin.HandlerFunc {
 			return
 		}
 
-		ginmiddleware.GINCreatedResponse(c, authResp)
+		ginmiddleware.GINCreatedResponse(c, resp)
 	}
 }
 
 // LoginHandler godoc
 //
 // @Summary		Login user
-// @Description	Login with email and password
+// @Description	Login with email and password. Failed logins make the next attempt wait longer and too many lock the account or the client IP for a while; a 429 response carries a Retry-After header
 // @Tags		auth
 // @Accept		json
 // @Produce		json
 // @Param		body	body		contract.LoginRequest	true	"login request"
 // @Success		200		{object}	ginmiddleware.Response{data=contract.AuthResponse}
 // @Failure		401		{object}	ginmiddleware.Response
+// @Failure		403		{object}	ginmiddleware.Response
+// @Failure		429		{object}	ginmiddleware.Response
 // @Router		/v1/auth/login [post]
 func LoginHandler(svc AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -70,12 +78,132 @@ func LoginHandler(svc AuthService) gin.HandlerFunc {
 			return
 		}
 
-		authResp, err := svc.Login(ctx, req)
+		authResp, err := svc.Login(ctx, req, clientInfo(c))
 		if err != nil {
-			ginmiddleware.GINUnauthorizedResponse(c)
+			if details, ok := i18n_err.Details(err).(contract.LoginRetryDetails); ok {
+				c.Header("Retry-After", strconv.Itoa(details.RetryAfter))
+			}
+			switch {
+			case errors.Is(err, apperrors.ErrUserDisabled), errors.Is(err, apperrors.ErrEmailNotVerified),
+				errors.Is(err, apperrors.ErrAccountLocked), errors.Is(err, apperrors.ErrTooManyLoginAttempts):
+				ginmiddleware.GINErrorResponse(c, err)
+			default:
+				ginmiddleware.GINUnauthorizedResponse(c)
+			}
 			return
 		}
 
 		ginmiddleware.GINSuccessResponse(c, authResp)
 	}
 }
+
+// RefreshHandler godoc
+//
+// @Summary		Refresh access token
+// @Description	Exchange a refresh token for a new access token and refresh token. A refresh token can only be used once; reusing one revokes its session
+// @Tags		auth
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.RefreshRequest	true	"refresh request"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.AuthResponse}
+// @Failure		401		{object}	ginmiddleware.Response
+// @Failure		403		{object}	ginmiddleware.Response
+// @Router		/v1/auth/refresh [post]
+func RefreshHandler(svc AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.RefreshRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		authResp, err := svc.Refresh(ctx, req, clientInfo(c))
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, authResp)
+	}
+}
+
+// LogoutHandler godoc
+//
+// @Summary		Logout
+// @Description	Revoke the current session and its access token
+// @Tags		auth
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		401	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/auth/logout [post]
+func LogoutHandler(svc AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		sessionID, ok := ginmiddleware.GetSessionID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		if err := svc.Logout(ctx, sessionID); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// AcceptInvitationHandler godoc
+//
+// @Summary		Accept invitation
+// @Description	Create an account from an invitation token and log in. The role and teams come from the invitation
+// @Tags		auth
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.AcceptInvitationRequest	true	"accept invitation request"
+// @Success		201		{object}	ginmiddleware.Response{data=contract.AuthResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		409		{object}	ginmiddleware.Response
+// @Router		/v1/auth/invitations/accept [post]
+func AcceptInvitationHandler(svc AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.AcceptInvitationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := contract.ValidatePassword(req.Password); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		authResp, err := svc.AcceptInvitation(ctx, req, clientInfo(c))
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINCreatedResponse(c, authResp)
+	}
+}
+
+// clientInfo describes the device of the request for the session list.
+func clientInfo(c *gin.Context) contract.ClientInfo {
+	userAgent := []rune(c.Request.UserAgent())
+	if len(userAgent) > 255 {
+		userAgent = userAgent[:255]
+	}
+	return contract.ClientInfo{
+		UserAgent: string(userAgent),
+		IPAddress: c.ClientIP(),
+		Language:  i18n.GetSupportedLocale(ginmiddleware.GetLanguage(c)),
+	}
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// RotateFeedTokenHandler godoc
+//
+// @Summary		Rotate calendar feed token
+// @Description	Issue a new token for the iCalendar fixture feeds and revoke the previous one
+// @Tags		calendar
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=contract.FeedTokenResponse}
+// @Failure		401	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/me/feed-token [post]
+func RotateFeedTokenHandler(svc CalendarService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		userID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		resp, err := svc.RotateFeedToken(ctx, userID)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetAllFixturesFeedHandler godoc
+//
+// @Summary		All fixtures calendar feed
+// @Description	iCalendar feed of every match, authenticated with a feed token
+// @Tags		calendar
+// @Produce		text/calendar
+// @Param		token	query		string	true	"feed token"
+// @Success		200		{string}	string
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		401		{object}	ginmiddleware.Response
+// @Router		/v1/matches/fixtures.ics [get]
+func GetAllFixturesFeedHandler(svc CalendarService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var query contract.FeedQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		body, err := svc.GetAllFixturesFeed(ctx, query.Token)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		c.Data(http.StatusOK, calendarContentType, body)
+	}
+}
+
+// GetTeamFixturesFeedHandler godoc
+//
+// @Summary		Team fixtures calendar feed
+// @Description	iCalendar feed of a team's matches, authenticated with a feed token
+// @Tags		calendar
+// @Produce		text/calendar
+// @Param		id		path		int		true	"team ID"
+// @Param		token	query		string	true	"feed token"
+// @Success		200		{string}	string
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		401		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Router		/v1/teams/{id}/fixtures.ics [get]
+func GetTeamFixturesFeedHandler(svc CalendarService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var query contract.FeedQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		body, err := svc.GetTeamFixturesFeed(ctx, query.Token, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		c.Data(http.StatusOK, calendarContentType, body)
+	}
+}
@@ -6,25 +6,66 @@ import (
 )
 
 type AuthService interface {
-	Register(ctx context.Context, req contract.RegisterRequest) (*contract.AuthResponse, error)
-	Login(ctx context.Context, req contract.LoginRequest) (*contract.AuthResponse, error)
+	Register(ctx context.Context, req contract.RegisterRequest, client contract.ClientInfo) (*contract.RegisterResponse, error)
+	Login(ctx context.Context, req contract.LoginRequest, client contract.ClientInfo) (*contract.AuthResponse, error)
+	AcceptInvitation(ctx context.Context, req contract.AcceptInvitationRequest, client contract.ClientInfo) (*contract.AuthResponse, error)
+	Refresh(ctx context.Context, req contract.RefreshRequest, client contract.ClientInfo) (*contract.AuthResponse, error)
+	Logout(ctx context.Context, sessionID int64) error
+	GetSessions(ctx context.Context, userID, currentSessionID int64) ([]contract.SessionResponse, error)
+	RevokeSession(ctx context.Context, userID, id int64) error
+}
+
+type AccountService interface {
+	ForgotPassword(ctx context.Context, req contract.ForgotPasswordRequest, lang string) error
+	ResetPassword(ctx context.Context, req contract.ResetPasswordRequest) error
+	VerifyEmail(ctx context.Context, req contract.VerifyEmailRequest) error
+	ResendVerification(ctx context.Context, req contract.ResendVerificationRequest, lang string) error
+}
+
+type KeyService interface {
+	GetJWKS(ctx context.Context) contract.JWKSResponse
+}
+
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, actorID int64, req contract.CreateAPIKeyRequest) (*contract.APIKeyResponse, error)
+	GetAPIKeys(ctx context.Context) ([]contract.APIKeyResponse, error)
+	DeleteAPIKey(ctx context.Context, id int64) error
+}
+
+type UserService interface {
+	GetAllUsers(ctx context.Context) ([]contract.UserResponse, error)
+	GetUser(ctx context.Context, id int64) (*contract.UserResponse, error)
+	ChangeRole(ctx context.Context, actorID, id int64, req contract.ChangeUserRoleRequest) (*contract.UserResponse, error)
+	DisableUser(ctx context.Context, actorID, id int64) (*contract.UserResponse, error)
+	EnableUser(ctx context.Context, actorID, id int64) (*contract.UserResponse, error)
+	DeleteUser(ctx context.Context, actorID, id int64) error
+	RevokeSessions(ctx context.Context, id int64) error
+	UnlockUser(ctx context.Context, id int64) error
+	CreateInvitation(ctx context.Context, actorID int64, req contract.CreateInvitationRequest) (*contract.InvitationResponse, error)
+	GetInvitations(ctx context.Context) ([]contract.InvitationResponse, error)
+	DeleteInvitation(ctx context.Context, id int64) error
 }
 
 type TeamService interface {
 	CreateTeam(ctx context.Context, req contract.CreateTeamRequest) (*contract.TeamResponse, error)
-	GetTeam(ctx context.Context, id int64) (*contract.TeamResponse, error)
-	GetAllTeams(ctx context.Context) ([]contract.TeamResponse, error)
-	UpdateTeam(ctx context.Context, id int64, req contract.UpdateTeamRequest) (*contract.TeamResponse, error)
+	GetTeam(ctx context.Context, id int64, query contract.TeamQuery) (*contract.TeamResponse, error)
+	GetAllTeams(ctx context.Context, query contract.TeamQuery) ([]contract.TeamResponse, error)
+	UpdateTeam(ctx context.Context, id int64, req contract.UpdateTeamRequest, scope *contract.TeamScope) (*contract.TeamResponse, error)
 	DeleteTeam(ctx context.Context, id int64) error
+	GetTeamStats(ctx context.Context, id int64, req contract.TeamStatsRequest) (*contract.TeamStatsResponse, error)
+	GetHeadToHead(ctx context.Context, id, opponentID int64, req contract.HeadToHeadRequest) (*contract.HeadToHeadResponse, error)
+	GetTeamForm(ctx context.Context, id int64, req contract.TeamFormRequest) (*contract.TeamFormResponse, error)
 }
 
 type PlayerService interface {
-	CreatePlayer(ctx context.Context, req contract.CreatePlayerRequest) (*contract.PlayerResponse, error)
+	CreatePlayer(ctx context.Context, req contract.CreatePlayerRequest, scope *contract.TeamScope) (*contract.PlayerResponse, error)
 	GetPlayer(ctx context.Context, id int64) (*contract.PlayerResponse, error)
 	GetAllPlayers(ctx context.Context) ([]contract.PlayerResponse, error)
 	GetPlayersByTeam(ctx context.Context, teamID int64) ([]contract.PlayerResponse, error)
-	UpdatePlayer(ctx context.Context, id int64, req contract.UpdatePlayerRequest) (*contract.PlayerResponse, error)
-	DeletePlayer(ctx context.Context, id int64) error
+	UpdatePlayer(ctx context.Context, id int64, req contract.UpdatePlayerRequest, scope *contract.TeamScope) (*contract.PlayerResponse, error)
+	DeletePlayer(ctx context.Context, id int64, scope *contract.TeamScope) error
+	GetPlayerStats(ctx context.Context, id int64, req contract.PlayerStatsRequest) (*contract.PlayerStatsResponse, error)
+	GetLeaderboard(ctx context.Context, req contract.PlayerLeaderboardRequest) (*contract.PlayerLeaderboardResponse, error)
 }
 
 type MatchService interface {
@@ -35,4 +76,56 @@ type MatchService interface {
 	DeleteMatch(ctx context.Context, id int64) error
 	SubmitResult(ctx context.Context, matchID int64, req contract.SubmitResultRequest) (*contract.MatchResponse, error)
 	GetMatchReport(ctx context.Context, matchID int64) (*contract.MatchReportResponse, error)
+	GetMatchPrediction(ctx context.Context, matchID int64) (*contract.MatchPredictionResponse, error)
+}
+
+type RatingService interface {
+	GetRankings(ctx context.Context, query contract.RankingsQuery) ([]contract.RankingEntry, error)
+	GetTeamRatingHistory(ctx context.Context, teamID int64) (*contract.TeamRatingHistoryResponse, error)
+}
+
+type SimulationService interface {
+	SimulateSeason(ctx context.Context, req contract.SeasonSimulationRequest) (*contract.SeasonSimulationResponse, error)
+}
+
+type StandingsService interface {
+	GetStandings(ctx context.Context, query contract.StandingsQuery) ([]contract.StandingEntry, error)
+	GetWhatIfStandings(ctx context.Context, req contract.WhatIfStandingsRequest) (*contract.WhatIfStandingsResponse, error)
+}
+
+type CalendarService interface {
+	RotateFeedToken(ctx context.Context, userID int64) (*contract.FeedTokenResponse, error)
+	GetAllFixturesFeed(ctx context.Context, token string) ([]byte, error)
+	GetTeamFixturesFeed(ctx context.Context, token string, teamID int64) ([]byte, error)
+}
+
+type VenueService interface {
+	CreateVenue(ctx context.Context, req contract.CreateVenueRequest) (*contract.VenueResponse, error)
+	GetVenue(ctx context.Context, id int64) (*contract.VenueResponse, error)
+	GetAllVenues(ctx context.Context) ([]contract.VenueResponse, error)
+	UpdateVenue(ctx context.Context, id int64, req contract.UpdateVenueRequest) (*contract.VenueResponse, error)
+	DeleteVenue(ctx context.Context, id int64) error
+	CreateVenueBlock(ctx context.Context, venueID int64, req contract.CreateVenueBlockRequest) (*contract.VenueBlockResponse, error)
+	DeleteVenueBlock(ctx context.Context, venueID, blockID int64) error
+	GetVenueCalendar(ctx context.Context, venueID int64, query contract.VenueCalendarQuery) (*contract.VenueCalendarResponse, error)
+}
+
+type OfficialService interface {
+	CreateOfficial(ctx context.Context, req contract.CreateOfficialRequest) (*contract.OfficialResponse, error)
+	GetOfficial(ctx context.Context, id int64) (*contract.OfficialResponse, error)
+	GetAllOfficials(ctx context.Context) ([]contract.OfficialResponse, error)
+	UpdateOfficial(ctx context.Context, id int64, req contract.UpdateOfficialRequest) (*contract.OfficialResponse, error)
+	DeleteOfficial(ctx context.Context, id int64) error
+	AssignMatchOfficials(ctx context.Context, matchID int64, req contract.AssignMatchOfficialsRequest) (*contract.MatchOfficialsResponse, error)
+	GetMatchOfficials(ctx context.Context, matchID int64) (*contract.MatchOfficialsResponse, error)
+	GetOfficialReport(ctx context.Context, id int64, query contract.OfficialReportQuery) (*contract.OfficialReportResponse, error)
+}
+
+type StaffService interface {
+	CreateStaff(ctx context.Context, req contract.CreateStaffRequest, scope *contract.TeamScope) (*contract.StaffResponse, error)
+	GetStaff(ctx context.Context, id int64) (*contract.StaffResponse, error)
+	GetAllStaff(ctx context.Context) ([]contract.StaffResponse, error)
+	GetStaffHistory(ctx context.Context, teamID int64) ([]contract.StaffResponse, error)
+	UpdateStaff(ctx context.Context, id int64, req contract.UpdateStaffRequest, scope *contract.TeamScope) (*contract.StaffResponse, error)
+	DeleteStaff(ctx context.Context, id int64, scope *contract.TeamScope) error
 }
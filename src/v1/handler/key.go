@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go-test/src/v1/contract"
+)
+
+// jwksCacheControl lets clients cache the key set for five minutes. Wait at
+// least that long between generating and rotating a key.
+const jwksCacheControl = "public, max-age=300"
+
+// GetJWKSHandler godoc
+//
+// @Summary		JSON Web Key Set
+// @Description	Public keys access tokens are verified with, selected by the token's kid header. Returned as a plain JWKS document without the response envelope
+// @Tags		auth
+// @Produce		json
+// @Success		200	{object}	contract.JWKSResponse
+// @Router		/.well-known/jwks.json [get]
+func GetJWKSHandler(svc KeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var jwks contract.JWKSResponse = svc.GetJWKS(ctx)
+
+		c.Header("Cache-Control", jwksCacheControl)
+		c.JSON(http.StatusOK, jwks)
+	}
+}
@@ -2,9 +2,12 @@ package handler
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
 	"go-test/src/v1/contract"
 )
 
@@ -16,9 +19,13 @@ import (
 // @Accept		json
 // @Produce		json
 // @Param		body	body		contract.CreateMatchRequest	true	"create match request"
+// @Param		force	query		bool						false	"admin only: save despite scheduling conflicts"
+// @Param		tz		query		string						false	"IANA timezone to render kickoff_at in (or X-Timezone header)"
 // @Success		201		{object}	ginmiddleware.Response{data=contract.MatchResponse}
 // @Failure		400		{object}	ginmiddleware.Response
+// @Failure		403		{object}	ginmiddleware.Response
 // @Failure		404		{object}	ginmiddleware.Response
+// @Failure		409		{object}	ginmiddleware.Response
 // @Security	BearerAuth
 // @Router		/v1/matches [post]
 func CreateMatchHandler(svc MatchService) gin.HandlerFunc {
@@ -31,12 +38,24 @@ func CreateMatchHandler(svc MatchService) gin.HandlerFunc {
 			return
 		}
 
+		force, ok := bindScheduleOverride(c)
+		if !ok {
+			return
+		}
+		req.Force = force
+
+		loc, ok := bindDisplayTimezone(c)
+		if !ok {
+			return
+		}
+
 		resp, err := svc.CreateMatch(ctx, req)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
 		}
 
+		localizeMatch(resp, loc)
 		ginmiddleware.GINCreatedResponse(c, resp)
 	}
 }
@@ -48,6 +67,7 @@ func CreateMatchHandler(svc MatchService) gin.HandlerFunc {
 // @Tags		matches
 // @Produce		json
 // @Param		id	path		int	true	"match ID"
+// @Param		tz	query		string	false	"IANA timezone to render kickoff_at in (or X-Timezone header)"
 // @Success		200	{object}	ginmiddleware.Response{data=contract.MatchResponse}
 // @Failure		404	{object}	ginmiddleware.Response
 // @Security	BearerAuth
@@ -62,12 +82,18 @@ func GetMatchHandler(svc MatchService) gin.HandlerFunc {
 			return
 		}
 
+		loc, ok := bindDisplayTimezone(c)
+		if !ok {
+			return
+		}
+
 		resp, err := svc.GetMatch(ctx, id)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
 		}
 
+		localizeMatch(resp, loc)
 		ginmiddleware.GINSuccessResponse(c, resp)
 	}
 }
@@ -78,6 +104,7 @@ func GetMatchHandler(svc MatchService) gin.HandlerFunc {
 // @Description	Get list of all matches
 // @Tags		matches
 // @Produce		json
+// @Param		tz	query		string	false	"IANA timezone to render kickoff_at in (or X-Timezone header)"
 // @Success		200	{object}	ginmiddleware.Response{data=[]contract.MatchResponse}
 // @Failure		500	{object}	ginmiddleware.Response
 // @Security	BearerAuth
@@ -86,12 +113,20 @@ func GetAllMatchesHandler(svc MatchService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 
+		loc, ok := bindDisplayTimezone(c)
+		if !ok {
+			return
+		}
+
 		resp, err := svc.GetAllMatches(ctx)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
 		}
 
+		for i := range resp {
+			localizeMatch(&resp[i], loc)
+		}
 		ginmiddleware.GINSuccessResponse(c, resp)
 	}
 }
@@ -105,9 +140,13 @@ func GetAllMatchesHandler(svc MatchService) gin.HandlerFunc {
 // @Produce		json
 // @Param		id		path		int							true	"match ID"
 // @Param		body	body		contract.UpdateMatchRequest	true	"update match request"
+// @Param		force	query		bool						false	"admin only: save despite scheduling conflicts"
+// @Param		tz		query		string						false	"IANA timezone to render kickoff_at in (or X-Timezone header)"
 // @Success		200		{object}	ginmiddleware.Response{data=contract.MatchResponse}
 // @Failure		400		{object}	ginmiddleware.Response
+// @Failure		403		{object}	ginmiddleware.Response
 // @Failure		404		{object}	ginmiddleware.Response
+// @Failure		409		{object}	ginmiddleware.Response
 // @Security	BearerAuth
 // @Router		/v1/matches/{id} [put]
 func UpdateMatchHandler(svc MatchService) gin.HandlerFunc {
@@ -126,12 +165,24 @@ func UpdateMatchHandler(svc MatchService) gin.HandlerFunc {
 			return
 		}
 
+		force, ok := bindScheduleOverride(c)
+		if !ok {
+			return
+		}
+		req.Force = force
+
+		loc, ok := bindDisplayTimezone(c)
+		if !ok {
+			return
+		}
+
 		resp, err := svc.UpdateMatch(ctx, id, req)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
 		}
 
+		localizeMatch(resp, loc)
 		ginmiddleware.GINSuccessResponse(c, resp)
 	}
 }
@@ -175,6 +226,7 @@ func DeleteMatchHandler(svc MatchService) gin.HandlerFunc {
 // @Produce		json
 // @Param		id		path		int							true	"match ID"
 // @Param		body	body		contract.SubmitResultRequest	true	"submit result request"
+// @Param		tz		query		string						false	"IANA timezone to render kickoff_at in (or X-Timezone header)"
 // @Success		200		{object}	ginmiddleware.Response{data=contract.MatchResponse}
 // @Failure		400		{object}	ginmiddleware.Response
 // @Failure		404		{object}	ginmiddleware.Response
@@ -196,12 +248,18 @@ func SubmitResultHandler(svc MatchService) gin.HandlerFunc {
 			return
 		}
 
+		loc, ok := bindDisplayTimezone(c)
+		if !ok {
+			return
+		}
+
 		resp, err := svc.SubmitResult(ctx, id, req)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
 		}
 
+		localizeMatch(resp, loc)
 		ginmiddleware.GINSuccessResponse(c, resp)
 	}
 }
@@ -213,6 +271,7 @@ func SubmitResultHandler(svc MatchService) gin.HandlerFunc {
 // @Tags		matches
 // @Produce		json
 // @Param		id	path		int	true	"match ID"
+// @Param		tz	query		string	false	"IANA timezone to render kickoff_at in (or X-Timezone header)"
 // @Success		200	{object}	ginmiddleware.Response{data=contract.MatchReportResponse}
 // @Failure		400	{object}	ginmiddleware.Response
 // @Failure		404	{object}	ginmiddleware.Response
@@ -228,12 +287,111 @@ func GetMatchReportHandler(svc MatchService) gin.HandlerFunc {
 			return
 		}
 
+		loc, ok := bindDisplayTimezone(c)
+		if !ok {
+			return
+		}
+
 		resp, err := svc.GetMatchReport(ctx, id)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
 		}
 
+		localizeMatchReport(resp, loc)
 		ginmiddleware.GINSuccessResponse(c, resp)
 	}
 }
+
+// GetMatchPredictionHandler godoc
+//
+// @Summary		Get match prediction
+// @Description	Get home/draw/away probabilities and the most likely scorelines for a scheduled match
+// @Tags		matches
+// @Produce		json
+// @Param		id	path		int	true	"match ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.MatchPredictionResponse}
+// @Failure		400	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/matches/{id}/prediction [get]
+func GetMatchPredictionHandler(svc MatchService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetMatchPrediction(ctx, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// bindScheduleOverride reads ?force=true. Only roles or API keys with the
+// schedule override permission may force a save; for anyone else the request
+// is answered with 403 and ok is false.
+func bindScheduleOverride(c *gin.Context) (force bool, ok bool) {
+	var query contract.ScheduleOverrideQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		ginmiddleware.GINBadRequestResponse(c)
+		return false, false
+	}
+
+	if query.Force {
+		if !ginmiddleware.HasPermission(c, string(entity.PermissionScheduleOverride), entity.Authorize) {
+			ginmiddleware.GINForbiddenResponse(c)
+			return false, false
+		}
+	}
+
+	return query.Force, true
+}
+
+// bindDisplayTimezone reads the timezone to render kickoff times in from
+// ?tz= or the X-Timezone header. A nil location keeps the match timezone.
+func bindDisplayTimezone(c *gin.Context) (*time.Location, bool) {
+	var query contract.DisplayTimezoneQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		ginmiddleware.GINBadRequestResponse(c)
+		return nil, false
+	}
+
+	name := query.Timezone
+	if name == "" {
+		name = c.GetHeader("X-Timezone")
+	}
+	if name == "" {
+		return nil, true
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		ginmiddleware.GINErrorResponse(c, apperrors.ErrInvalidTimezone)
+		return nil, false
+	}
+	return loc, true
+}
+
+func localizeMatch(resp *contract.MatchResponse, loc *time.Location) {
+	if loc == nil {
+		return
+	}
+	resp.KickoffAt = resp.KickoffAt.In(loc)
+	resp.DisplayTimezone = loc.String()
+}
+
+func localizeMatchReport(resp *contract.MatchReportResponse, loc *time.Location) {
+	if loc == nil {
+		return
+	}
+	resp.KickoffAt = resp.KickoffAt.In(loc)
+	resp.DisplayTimezone = loc.String()
+}
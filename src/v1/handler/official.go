@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// CreateOfficialHandler godoc
+//
+// @Summary		Create official
+// @Description	Create a new match official (referee, assistant, fourth official or VAR)
+// @Tags		officials
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.CreateOfficialRequest	true	"create official request"
+// @Success		201		{object}	ginmiddleware.Response{data=contract.OfficialResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/officials [post]
+func CreateOfficialHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.CreateOfficialRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.CreateOfficial(ctx, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINCreatedResponse(c, resp)
+	}
+}
+
+// GetOfficialHandler godoc
+//
+// @Summary		Get official by ID
+// @Description	Get an official by its ID
+// @Tags		officials
+// @Produce		json
+// @Param		id	path		int	true	"official ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.OfficialResponse}
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/officials/{id} [get]
+func GetOfficialHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetOfficial(ctx, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetAllOfficialsHandler godoc
+//
+// @Summary		Get all officials
+// @Description	Get list of all officials ordered by name
+// @Tags		officials
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.OfficialResponse}
+// @Failure		500	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/officials [get]
+func GetAllOfficialsHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		resp, err := svc.GetAllOfficials(ctx)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// UpdateOfficialHandler godoc
+//
+// @Summary		Update official
+// @Description	Update an official by ID
+// @Tags		officials
+// @Accept		json
+// @Produce		json
+// @Param		id		path		int							true	"official ID"
+// @Param		body	body		contract.UpdateOfficialRequest	true	"update official request"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.OfficialResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/officials/{id} [put]
+func UpdateOfficialHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.UpdateOfficialRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.UpdateOfficial(ctx, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// DeleteOfficialHandler godoc
+//
+// @Summary		Delete official
+// @Description	Soft delete a match official by ID
+// @Tags		officials
+// @Produce		json
+// @Param		id	path		int	true	"official ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/officials/{id} [delete]
+func DeleteOfficialHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.DeleteOfficial(ctx, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// AssignMatchOfficialsHandler godoc
+//
+// @Summary		Assign match officials
+// @Description	Replace the officials of a match. A match has at most one referee, two assistant referees, one fourth official and one VAR. Officials from the home city of either team or over the weekly match limit are refused with 409
+// @Tags		officials
+// @Accept		json
+// @Produce		json
+// @Param		id		path		int										true	"match ID"
+// @Param		body	body		contract.AssignMatchOfficialsRequest	true	"assign match officials request"
+// @Param		force	query		bool									false	"admin only: save despite assignment conflicts"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.MatchOfficialsResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		403		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Failure		409		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/matches/{id}/officials [put]
+func AssignMatchOfficialsHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.AssignMatchOfficialsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		force, ok := bindScheduleOverride(c)
+		if !ok {
+			return
+		}
+		req.Force = force
+
+		resp, err := svc.AssignMatchOfficials(ctx, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetMatchOfficialsHandler godoc
+//
+// @Summary		Get match officials
+// @Description	Get the officials assigned to a match, ordered by role
+// @Tags		officials
+// @Produce		json
+// @Param		id	path		int	true	"match ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.MatchOfficialsResponse}
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/matches/{id}/officials [get]
+func GetMatchOfficialsHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetMatchOfficials(ctx, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetOfficialReportHandler godoc
+//
+// @Summary		Get official report
+// @Description	Get the matches of an official per role and the cards shown in the matches they refereed
+// @Tags		officials
+// @Produce		json
+// @Param		id		path		int		true	"official ID"
+// @Param		from	query		string	false	"start date (YYYY-MM-DD)"
+// @Param		to		query		string	false	"end date (YYYY-MM-DD)"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.OfficialReportResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/officials/{id}/report [get]
+func GetOfficialReportHandler(svc OfficialService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var query contract.OfficialReportQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetOfficialReport(ctx, id, query)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
@@ -31,7 +31,7 @@ func CreatePlayerHandler(svc PlayerService) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := svc.CreatePlayer(ctx, req)
+		resp, err := svc.CreatePlayer(ctx, req, teamScope(c))
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
@@ -157,7 +157,7 @@ func UpdatePlayerHandler(svc PlayerService) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := svc.UpdatePlayer(ctx, id, req)
+		resp, err := svc.UpdatePlayer(ctx, id, req, teamScope(c))
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
@@ -188,7 +188,7 @@ func DeletePlayerHandler(svc PlayerService) gin.HandlerFunc {
 			return
 		}
 
-		if err := svc.DeletePlayer(ctx, id); err != nil {
+		if err := svc.DeletePlayer(ctx, id, teamScope(c)); err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
 		}
@@ -196,3 +196,79 @@ func DeletePlayerHandler(svc PlayerService) gin.HandlerFunc {
 		ginmiddleware.GINSuccessResponse(c, nil)
 	}
 }
+
+// GetPlayerStatsHandler godoc
+//
+// @Summary		Get player statistics
+// @Description	Get goals and matches with a goal for a player, in total and broken down by team and season
+// @Tags		players
+// @Produce		json
+// @Param		id		path		int		true	"player ID"
+// @Param		from	query		string	false	"start date (YYYY-MM-DD)"
+// @Param		to		query		string	false	"end date (YYYY-MM-DD)"
+// @Param		as_of	query		string	false	"as of the end of this date (YYYY-MM-DD)"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.PlayerStatsResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/players/{id}/stats [get]
+func GetPlayerStatsHandler(svc PlayerService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.PlayerStatsRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetPlayerStats(ctx, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetPlayerLeaderboardHandler godoc
+//
+// @Summary		Get player leaderboard
+// @Description	Rank all players by goals or matches with a goal over an optional date range
+// @Tags		players
+// @Produce		json
+// @Param		metric	query		string	false	"ranking metric"	Enums(goals, matches_with_goal)
+// @Param		from	query		string	false	"start date (YYYY-MM-DD)"
+// @Param		to		query		string	false	"end date (YYYY-MM-DD)"
+// @Param		as_of	query		string	false	"as of the end of this date (YYYY-MM-DD)"
+// @Param		limit	query		int		false	"number of players (1-100, default 20)"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.PlayerLeaderboardResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/players/leaderboard [get]
+func GetPlayerLeaderboardHandler(svc PlayerService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.PlayerLeaderboardRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetLeaderboard(ctx, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
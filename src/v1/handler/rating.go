@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// GetRankingsHandler godoc
+//
+// @Summary		Get team rankings
+// @Description	Get all teams ordered by their current Elo rating, optionally as they stood at the end of a past date
+// @Tags		rankings
+// @Produce		json
+// @Param		as_of	query		string	false	"as of the end of this date (YYYY-MM-DD)"
+// @Success		200		{object}	ginmiddleware.Response{data=[]contract.RankingEntry}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/rankings [get]
+func GetRankingsHandler(svc RatingService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var query contract.RankingsQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetRankings(ctx, query)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetTeamRatingHistoryHandler godoc
+//
+// @Summary		Get team rating history
+// @Description	Get the Elo rating change of a team for every rated match
+// @Tags		teams
+// @Produce		json
+// @Param		id		path		int	true	"team ID"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.TeamRatingHistoryResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/teams/{id}/rating-history [get]
+func GetTeamRatingHistoryHandler(svc RatingService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetTeamRatingHistory(ctx, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
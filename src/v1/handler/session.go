@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// GetSessionsHandler godoc
+//
+// @Summary		Get my sessions
+// @Description	Get the active sessions of the current user, most recently used first
+// @Tags		auth
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.SessionResponse}
+// @Failure		401	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/me/sessions [get]
+func GetSessionsHandler(svc AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		userID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+		sessionID, _ := ginmiddleware.GetSessionID(c)
+
+		var sessions []contract.SessionResponse
+		sessions, err := svc.GetSessions(ctx, userID, sessionID)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, sessions)
+	}
+}
+
+// RevokeSessionHandler godoc
+//
+// @Summary		Revoke my session
+// @Description	Sign the current user out of one of their sessions, e.g. on another device
+// @Tags		auth
+// @Produce		json
+// @Param		id	path		int	true	"session ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/me/sessions/{id} [delete]
+func RevokeSessionHandler(svc AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		userID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.RevokeSession(ctx, userID, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// SimulateSeasonHandler godoc
+//
+// @Summary		Simulate season
+// @Description	Monte Carlo simulation of the remaining scheduled matches, returning finishing position probabilities per team
+// @Tags		simulations
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.SeasonSimulationRequest	true	"season simulation request"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.SeasonSimulationResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/simulations/season [post]
+func SimulateSeasonHandler(svc SimulationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.SeasonSimulationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.SimulateSeason(ctx, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
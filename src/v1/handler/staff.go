@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// CreateStaffHandler godoc
+//
+// @Summary		Create staff member
+// @Description	Create a team staff member (head coach, assistant coach, goalkeeping coach or physio) with a tenure
+// @Tags		staff
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.CreateStaffRequest	true	"create staff request"
+// @Success		201		{object}	ginmiddleware.Response{data=contract.StaffResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/staff [post]
+func CreateStaffHandler(svc StaffService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.CreateStaffRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.CreateStaff(ctx, req, teamScope(c))
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINCreatedResponse(c, resp)
+	}
+}
+
+// GetStaffHandler godoc
+//
+// @Summary		Get staff member by ID
+// @Description	Get a team staff member by its ID
+// @Tags		staff
+// @Produce		json
+// @Param		id	path		int	true	"staff ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.StaffResponse}
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/staff/{id} [get]
+func GetStaffHandler(svc StaffService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetStaff(ctx, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetAllStaffHandler godoc
+//
+// @Summary		Get all staff
+// @Description	Get list of all team staff ordered by team and role
+// @Tags		staff
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.StaffResponse}
+// @Failure		500	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/staff [get]
+func GetAllStaffHandler(svc StaffService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		resp, err := svc.GetAllStaff(ctx)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// UpdateStaffHandler godoc
+//
+// @Summary		Update staff member
+// @Description	Update a team staff member by ID
+// @Tags		staff
+// @Accept		json
+// @Produce		json
+// @Param		id		path		int							true	"staff ID"
+// @Param		body	body		contract.UpdateStaffRequest	true	"update staff request"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.StaffResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/staff/{id} [put]
+func UpdateStaffHandler(svc StaffService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.UpdateStaffRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.UpdateStaff(ctx, id, req, teamScope(c))
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// DeleteStaffHandler godoc
+//
+// @Summary		Delete staff member
+// @Description	Soft delete a team staff member by ID
+// @Tags		staff
+// @Produce		json
+// @Param		id	path		int	true	"staff ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/staff/{id} [delete]
+func DeleteStaffHandler(svc StaffService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.DeleteStaff(ctx, id, teamScope(c)); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// GetStaffHistoryHandler godoc
+//
+// @Summary		Get team staff history
+// @Description	Get the past staff of a team whose tenure has ended, most recent first
+// @Tags		teams
+// @Produce		json
+// @Param		id	path		int	true	"team ID"
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.StaffResponse}
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/teams/{id}/staff/history [get]
+func GetStaffHistoryHandler(svc StaffService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		teamID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetStaffHistory(ctx, teamID)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// GetStandingsHandler godoc
+//
+// @Summary		Get standings
+// @Description	Get the league table from all completed matches, optionally as it stood at the end of a past date
+// @Tags		standings
+// @Produce		json
+// @Param		as_of	query		string	false	"reproduce the table as of this date (YYYY-MM-DD)"
+// @Success		200		{object}	ginmiddleware.Response{data=[]contract.StandingEntry}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/standings [get]
+func GetStandingsHandler(svc StandingsService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var query contract.StandingsQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetStandings(ctx, query)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetWhatIfStandingsHandler godoc
+//
+// @Summary		What-if standings
+// @Description	Get the league table as it would stand if the given scheduled matches ended with the given scores. Nothing is saved.
+// @Tags		standings
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.WhatIfStandingsRequest	true	"hypothetical results"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.WhatIfStandingsResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/standings/what-if [post]
+func GetWhatIfStandingsHandler(svc StandingsService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.WhatIfStandingsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetWhatIfStandings(ctx, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/entity"
 	"go-test/src/v1/contract"
 )
 
@@ -23,8 +24,10 @@ import (
 // @Param		year_founded	formData	int		true	"year founded (1800-2100)"
 // @Param		address			formData	string	false	"team address"
 // @Param		city			formData	string	true	"team city"
+// @Param		home_venue_id	formData	int		false	"home venue ID"
 // @Success		201		{object}	ginmiddleware.Response{data=contract.TeamResponse}
 // @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
 // @Security	BearerAuth
 // @Router		/v1/teams [post]
 func CreateTeamHandler(svc TeamService) gin.HandlerFunc {
@@ -52,6 +55,15 @@ func CreateTeamHandler(svc TeamService) gin.HandlerFunc {
 			City:        city,
 		}
 
+		if homeVenueIDStr := c.PostForm("home_venue_id"); homeVenueIDStr != "" {
+			homeVenueID, err := strconv.ParseInt(homeVenueIDStr, 10, 64)
+			if err != nil || homeVenueID < 1 {
+				ginmiddleware.GINBadRequestResponse(c)
+				return
+			}
+			req.HomeVenueID = homeVenueID
+		}
+
 		if file, err := c.FormFile("logo"); err == nil && file != nil {
 			ext := filepath.Ext(file.Filename)
 			savePath := filepath.Join("uploads", "teams", uuid.New().String()+ext)
@@ -74,11 +86,14 @@ func CreateTeamHandler(svc TeamService) gin.HandlerFunc {
 // GetTeamHandler godoc
 //
 // @Summary		Get team by ID
-// @Description	Get a football team by its ID
+// @Description	Get a football team by its ID with the staff currently in post
 // @Tags		teams
 // @Produce		json
-// @Param		id	path		int	true	"team ID"
+// @Param		id			path		int		true	"team ID"
+// @Param		include		query		string	false	"include extra data"	Enums(form)
+// @Param		form_last	query		int		false	"number of matches in the form string (1-20, default 5)"
 // @Success		200	{object}	ginmiddleware.Response{data=contract.TeamResponse}
+// @Failure		400	{object}	ginmiddleware.Response
 // @Failure		404	{object}	ginmiddleware.Response
 // @Security	BearerAuth
 // @Router		/v1/teams/{id} [get]
@@ -92,7 +107,13 @@ func GetTeamHandler(svc TeamService) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := svc.GetTeam(ctx, id)
+		var query contract.TeamQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetTeam(ctx, id, query)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
@@ -108,7 +129,10 @@ func GetTeamHandler(svc TeamService) gin.HandlerFunc {
 // @Description	Get list of all football teams
 // @Tags		teams
 // @Produce		json
+// @Param		include		query		string	false	"include extra data"	Enums(form)
+// @Param		form_last	query		int		false	"number of matches in the form string (1-20, default 5)"
 // @Success		200	{object}	ginmiddleware.Response{data=[]contract.TeamResponse}
+// @Failure		400	{object}	ginmiddleware.Response
 // @Failure		500	{object}	ginmiddleware.Response
 // @Security	BearerAuth
 // @Router		/v1/teams [get]
@@ -116,7 +140,13 @@ func GetAllTeamsHandler(svc TeamService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 
-		resp, err := svc.GetAllTeams(ctx)
+		var query contract.TeamQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetAllTeams(ctx, query)
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
@@ -139,6 +169,7 @@ func GetAllTeamsHandler(svc TeamService) gin.HandlerFunc {
 // @Param		year_founded	formData	int		false	"year founded (1800-2100)"
 // @Param		address			formData	string	false	"team address"
 // @Param		city			formData	string	false	"team city"
+// @Param		home_venue_id	formData	int		false	"home venue ID"
 // @Success		200		{object}	ginmiddleware.Response{data=contract.TeamResponse}
 // @Failure		400		{object}	ginmiddleware.Response
 // @Failure		404		{object}	ginmiddleware.Response
@@ -169,6 +200,15 @@ func UpdateTeamHandler(svc TeamService) gin.HandlerFunc {
 			req.YearFounded = yearFounded
 		}
 
+		if homeVenueIDStr := c.PostForm("home_venue_id"); homeVenueIDStr != "" {
+			homeVenueID, err := strconv.ParseInt(homeVenueIDStr, 10, 64)
+			if err != nil || homeVenueID < 1 {
+				ginmiddleware.GINBadRequestResponse(c)
+				return
+			}
+			req.HomeVenueID = homeVenueID
+		}
+
 		if file, err := c.FormFile("logo"); err == nil && file != nil {
 			ext := filepath.Ext(file.Filename)
 			savePath := filepath.Join("uploads", "teams", uuid.New().String()+ext)
@@ -178,7 +218,7 @@ func UpdateTeamHandler(svc TeamService) gin.HandlerFunc {
 			}
 		}
 
-		resp, err := svc.UpdateTeam(ctx, id, req)
+		resp, err := svc.UpdateTeam(ctx, id, req, teamScope(c))
 		if err != nil {
 			ginmiddleware.GINErrorResponse(c, err)
 			return
@@ -217,3 +257,140 @@ func DeleteTeamHandler(svc TeamService) gin.HandlerFunc {
 		ginmiddleware.GINSuccessResponse(c, nil)
 	}
 }
+
+// GetTeamStatsHandler godoc
+//
+// @Summary		Get team statistics
+// @Description	Get home/away record, goals, clean sheets, biggest win and loss for a team from completed matches
+// @Tags		teams
+// @Produce		json
+// @Param		id		path		int		true	"team ID"
+// @Param		from	query		string	false	"start date (YYYY-MM-DD)"
+// @Param		to		query		string	false	"end date (YYYY-MM-DD)"
+// @Param		as_of	query		string	false	"reproduce the statistics as of this date (YYYY-MM-DD)"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.TeamStatsResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/teams/{id}/stats [get]
+func GetTeamStatsHandler(svc TeamService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.TeamStatsRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetTeamStats(ctx, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetHeadToHeadHandler godoc
+//
+// @Summary		Get head-to-head history
+// @Description	Get all completed meetings between two teams with aggregate record, last five results and top scorers
+// @Tags		teams
+// @Produce		json
+// @Param		id		path		int	true	"team ID"
+// @Param		otherId	path		int	true	"opponent team ID"
+// @Param		as_of	query		string	false	"as of the end of this date (YYYY-MM-DD)"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.HeadToHeadResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/teams/{id}/head-to-head/{otherId} [get]
+func GetHeadToHeadHandler(svc TeamService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		otherID, err := strconv.ParseInt(c.Param("otherId"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.HeadToHeadRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetHeadToHead(ctx, id, otherID, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetTeamFormHandler godoc
+//
+// @Summary		Get team form
+// @Description	Get the last-N results form string and current and record streaks for a team
+// @Tags		teams
+// @Produce		json
+// @Param		id		path		int	true	"team ID"
+// @Param		last	query		int	false	"number of matches in the form string (1-20, default 5)"
+// @Param		as_of	query		string	false	"as of the end of this date (YYYY-MM-DD)"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.TeamFormResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/teams/{id}/form [get]
+func GetTeamFormHandler(svc TeamService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.TeamFormRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetTeamForm(ctx, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// teamScope returns the teams a team-scoped caller may write to, or nil for
+// league-wide roles.
+func teamScope(c *gin.Context) *contract.TeamScope {
+	userType, _ := ginmiddleware.GetUserType(c)
+	if !entity.UserRole(userType).IsTeamScoped() {
+		return nil
+	}
+	return &contract.TeamScope{TeamIDs: ginmiddleware.GetTeamIDs(c)}
+}
@@ -0,0 +1,376 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// GetAllUsersHandler godoc
+//
+// @Summary		Get all users
+// @Description	Get list of all users with their role and managed teams
+// @Tags		users
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.UserResponse}
+// @Failure		403	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users [get]
+func GetAllUsersHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		resp, err := svc.GetAllUsers(ctx)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetUserHandler godoc
+//
+// @Summary		Get user by ID
+// @Description	Get a user by its ID
+// @Tags		users
+// @Produce		json
+// @Param		id	path		int	true	"user ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.UserResponse}
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users/{id} [get]
+func GetUserHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetUser(ctx, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// ChangeUserRoleHandler godoc
+//
+// @Summary		Change user role
+// @Description	Change the role of a user and replace the teams they manage. Applies from the user's next token refresh
+// @Tags		users
+// @Accept		json
+// @Produce		json
+// @Param		id		path		int								true	"user ID"
+// @Param		body	body		contract.ChangeUserRoleRequest	true	"change role request"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.UserResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users/{id}/role [put]
+func ChangeUserRoleHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		actorID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.ChangeUserRoleRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.ChangeRole(ctx, actorID, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// DisableUserHandler godoc
+//
+// @Summary		Disable user
+// @Description	Block a user from logging in and revoke their sessions. The account is kept and can be enabled again
+// @Tags		users
+// @Produce		json
+// @Param		id	path		int	true	"user ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.UserResponse}
+// @Failure		400	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users/{id}/disable [post]
+func DisableUserHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		actorID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.DisableUser(ctx, actorID, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// EnableUserHandler godoc
+//
+// @Summary		Enable user
+// @Description	Allow a disabled user to log in again
+// @Tags		users
+// @Produce		json
+// @Param		id	path		int	true	"user ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.UserResponse}
+// @Failure		400	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users/{id}/enable [post]
+func EnableUserHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		actorID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.EnableUser(ctx, actorID, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// DeleteUserHandler godoc
+//
+// @Summary		Delete user
+// @Description	Soft delete a user, drop their team grants and revoke their sessions
+// @Tags		users
+// @Produce		json
+// @Param		id	path		int	true	"user ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		400	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users/{id} [delete]
+func DeleteUserHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		actorID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.DeleteUser(ctx, actorID, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// RevokeUserSessionsHandler godoc
+//
+// @Summary		Revoke user sessions
+// @Description	Sign a user out of every session and revoke their access tokens. The user can still log in again
+// @Tags		users
+// @Produce		json
+// @Param		id	path		int	true	"user ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users/{id}/sessions [delete]
+func RevokeUserSessionsHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.RevokeSessions(ctx, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// UnlockUserHandler godoc
+//
+// @Summary		Unlock user
+// @Description	Lift the login lockout of a user locked after too many failed logins
+// @Tags		users
+// @Produce		json
+// @Param		id	path		int	true	"user ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/users/{id}/unlock [post]
+func UnlockUserHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.UnlockUser(ctx, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// CreateInvitationHandler godoc
+//
+// @Summary		Create invitation
+// @Description	Invite a user with a role and, for team managers, the teams they manage. The token is only returned in this response
+// @Tags		invitations
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.CreateInvitationRequest	true	"create invitation request"
+// @Success		201		{object}	ginmiddleware.Response{data=contract.InvitationResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		409		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/invitations [post]
+func CreateInvitationHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		actorID, ok := ginmiddleware.GetUserID(c)
+		if !ok {
+			ginmiddleware.GINUnauthorizedResponse(c)
+			return
+		}
+
+		var req contract.CreateInvitationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.CreateInvitation(ctx, actorID, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINCreatedResponse(c, resp)
+	}
+}
+
+// GetInvitationsHandler godoc
+//
+// @Summary		Get all invitations
+// @Description	Get list of all invitations, newest first
+// @Tags		invitations
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.InvitationResponse}
+// @Failure		403	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/invitations [get]
+func GetInvitationsHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		resp, err := svc.GetInvitations(ctx)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// DeleteInvitationHandler godoc
+//
+// @Summary		Revoke invitation
+// @Description	Revoke an invitation so its token can no longer be accepted
+// @Tags		invitations
+// @Produce		json
+// @Param		id	path		int	true	"invitation ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/invitations/{id} [delete]
+func DeleteInvitationHandler(svc UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.DeleteInvitation(ctx, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ginmiddleware "go-test/lib/middleware/gin"
+	"go-test/src/v1/contract"
+)
+
+// CreateVenueHandler godoc
+//
+// @Summary		Create venue
+// @Description	Create a new venue or stadium
+// @Tags		venues
+// @Accept		json
+// @Produce		json
+// @Param		body	body		contract.CreateVenueRequest	true	"create venue request"
+// @Success		201		{object}	ginmiddleware.Response{data=contract.VenueResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues [post]
+func CreateVenueHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req contract.CreateVenueRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.CreateVenue(ctx, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINCreatedResponse(c, resp)
+	}
+}
+
+// GetVenueHandler godoc
+//
+// @Summary		Get venue by ID
+// @Description	Get a venue by its ID
+// @Tags		venues
+// @Produce		json
+// @Param		id	path		int	true	"venue ID"
+// @Success		200	{object}	ginmiddleware.Response{data=contract.VenueResponse}
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues/{id} [get]
+func GetVenueHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetVenue(ctx, id)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// GetAllVenuesHandler godoc
+//
+// @Summary		Get all venues
+// @Description	Get list of all venues ordered by name
+// @Tags		venues
+// @Produce		json
+// @Success		200	{object}	ginmiddleware.Response{data=[]contract.VenueResponse}
+// @Failure		500	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues [get]
+func GetAllVenuesHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		resp, err := svc.GetAllVenues(ctx)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// UpdateVenueHandler godoc
+//
+// @Summary		Update venue
+// @Description	Update a venue by ID
+// @Tags		venues
+// @Accept		json
+// @Produce		json
+// @Param		id		path		int							true	"venue ID"
+// @Param		body	body		contract.UpdateVenueRequest	true	"update venue request"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.VenueResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues/{id} [put]
+func UpdateVenueHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.UpdateVenueRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.UpdateVenue(ctx, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
+
+// DeleteVenueHandler godoc
+//
+// @Summary		Delete venue
+// @Description	Soft delete a venue by ID and unset it as home venue of its teams
+// @Tags		venues
+// @Produce		json
+// @Param		id	path		int	true	"venue ID"
+// @Success		200	{object}	ginmiddleware.Response
+// @Failure		404	{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues/{id} [delete]
+func DeleteVenueHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.DeleteVenue(ctx, id); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// CreateVenueBlockHandler godoc
+//
+// @Summary		Block venue dates
+// @Description	Block a venue for a date range (maintenance, events, shared tenancy). Matches already scheduled in the range are returned in conflicting_match_ids
+// @Tags		venues
+// @Accept		json
+// @Produce		json
+// @Param		id		path		int									true	"venue ID"
+// @Param		body	body		contract.CreateVenueBlockRequest	true	"create venue block request"
+// @Success		201		{object}	ginmiddleware.Response{data=contract.VenueBlockResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues/{id}/blocks [post]
+func CreateVenueBlockHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var req contract.CreateVenueBlockRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.CreateVenueBlock(ctx, id, req)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINCreatedResponse(c, resp)
+	}
+}
+
+// DeleteVenueBlockHandler godoc
+//
+// @Summary		Unblock venue dates
+// @Description	Remove a venue block
+// @Tags		venues
+// @Produce		json
+// @Param		id		path		int	true	"venue ID"
+// @Param		blockId	path		int	true	"venue block ID"
+// @Success		200		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues/{id}/blocks/{blockId} [delete]
+func DeleteVenueBlockHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		blockID, err := strconv.ParseInt(c.Param("blockId"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		if err := svc.DeleteVenueBlock(ctx, id, blockID); err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, nil)
+	}
+}
+
+// GetVenueCalendarHandler godoc
+//
+// @Summary		Get venue calendar
+// @Description	Get the matches and blocked dates of a venue, ordered by date. Defaults to the 90 days from today
+// @Tags		venues
+// @Produce		json
+// @Param		id		path		int		true	"venue ID"
+// @Param		from	query		string	false	"start date (YYYY-MM-DD)"
+// @Param		to		query		string	false	"end date (YYYY-MM-DD), at most 366 days after from"
+// @Success		200		{object}	ginmiddleware.Response{data=contract.VenueCalendarResponse}
+// @Failure		400		{object}	ginmiddleware.Response
+// @Failure		404		{object}	ginmiddleware.Response
+// @Security	BearerAuth
+// @Router		/v1/venues/{id}/calendar [get]
+func GetVenueCalendarHandler(svc VenueService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		var query contract.VenueCalendarQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			ginmiddleware.GINBadRequestResponse(c)
+			return
+		}
+
+		resp, err := svc.GetVenueCalendar(ctx, id, query)
+		if err != nil {
+			ginmiddleware.GINErrorResponse(c, err)
+			return
+		}
+
+		ginmiddleware.GINSuccessResponse(c, resp)
+	}
+}
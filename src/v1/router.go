@@ -3,6 +3,7 @@ package v1
 import (
 	"net/http"
 
+	"go-test/src/entity"
 	"go-test/src/v1/handler"
 	_ "go-test/swagger/v1"
 
@@ -28,15 +29,36 @@ func Router(r *gin.Engine, deps *APIDepedencies) {
 		swaggerHandler(c)
 	})
 
+	r.GET("/.well-known/jwks.json", handler.GetJWKSHandler(deps.Services.KeyService))
+
 	// Auth
 	auth := r.Group("/v1/auth")
 	{
 		auth.POST("/register", handler.RegisterHandler(deps.Services.AuthService))
 		auth.POST("/login", handler.LoginHandler(deps.Services.AuthService))
+		auth.POST("/invitations/accept", handler.AcceptInvitationHandler(deps.Services.AuthService))
+		auth.POST("/refresh", handler.RefreshHandler(deps.Services.AuthService))
+		auth.POST("/logout", deps.JWTMiddleware.Authenticate(), handler.LogoutHandler(deps.Services.AuthService))
+		auth.POST("/password/forgot", handler.ForgotPasswordHandler(deps.Services.AccountService))
+		auth.POST("/password/reset", handler.ResetPasswordHandler(deps.Services.AccountService))
+		auth.POST("/email/verify", handler.VerifyEmailHandler(deps.Services.AccountService))
+		auth.POST("/email/resend", handler.ResendVerificationHandler(deps.Services.AccountService))
+	}
+
+	// Calendar feeds authenticate with ?token= so calendar apps can subscribe
+	feeds := r.Group("/v1")
+	{
+		feeds.GET("/teams/:id/fixtures.ics", handler.GetTeamFixturesFeedHandler(deps.Services.CalendarService))
+		feeds.GET("/matches/fixtures.ics", handler.GetAllFixturesFeedHandler(deps.Services.CalendarService))
 	}
 
 	// Authenticated
-	authorized := r.Group("/v1", deps.JWTMiddleware.Authenticate())
+	authorized := r.Group("/v1", deps.JWTMiddleware.Authenticate(), deps.JWTMiddleware.RequirePermission(string(entity.PermissionRead)))
+
+	// can guards a write route with the permission it needs, see entity.rolePermissions
+	can := func(p entity.Permission) gin.HandlerFunc {
+		return deps.JWTMiddleware.RequirePermission(string(p))
+	}
 
 	// Team
 	teams := authorized.Group("/teams")
@@ -44,19 +66,36 @@ func Router(r *gin.Engine, deps *APIDepedencies) {
 		teams.GET("", handler.GetAllTeamsHandler(deps.Services.TeamService))
 		teams.GET("/:id", handler.GetTeamHandler(deps.Services.TeamService))
 		teams.GET("/:id/players", handler.GetPlayersByTeamHandler(deps.Services.PlayerService))
-		teams.POST("", handler.CreateTeamHandler(deps.Services.TeamService))
-		teams.PUT("/:id", handler.UpdateTeamHandler(deps.Services.TeamService))
-		teams.DELETE("/:id", handler.DeleteTeamHandler(deps.Services.TeamService))
+		teams.GET("/:id/staff/history", handler.GetStaffHistoryHandler(deps.Services.StaffService))
+		teams.GET("/:id/stats", handler.GetTeamStatsHandler(deps.Services.TeamService))
+		teams.GET("/:id/form", handler.GetTeamFormHandler(deps.Services.TeamService))
+		teams.GET("/:id/rating-history", handler.GetTeamRatingHistoryHandler(deps.Services.RatingService))
+		teams.GET("/:id/head-to-head/:otherId", handler.GetHeadToHeadHandler(deps.Services.TeamService))
+		teams.POST("", can(entity.PermissionTeamsWrite), handler.CreateTeamHandler(deps.Services.TeamService))
+		teams.PUT("/:id", can(entity.PermissionTeamsUpdate), handler.UpdateTeamHandler(deps.Services.TeamService))
+		teams.DELETE("/:id", can(entity.PermissionTeamsWrite), handler.DeleteTeamHandler(deps.Services.TeamService))
 	}
 
 	// Player
 	players := authorized.Group("/players")
 	{
 		players.GET("", handler.GetAllPlayersHandler(deps.Services.PlayerService))
+		players.GET("/leaderboard", handler.GetPlayerLeaderboardHandler(deps.Services.PlayerService))
 		players.GET("/:id", handler.GetPlayerHandler(deps.Services.PlayerService))
-		players.POST("", handler.CreatePlayerHandler(deps.Services.PlayerService))
-		players.PUT("/:id", handler.UpdatePlayerHandler(deps.Services.PlayerService))
-		players.DELETE("/:id", handler.DeletePlayerHandler(deps.Services.PlayerService))
+		players.GET("/:id/stats", handler.GetPlayerStatsHandler(deps.Services.PlayerService))
+		players.POST("", can(entity.PermissionPlayersWrite), handler.CreatePlayerHandler(deps.Services.PlayerService))
+		players.PUT("/:id", can(entity.PermissionPlayersWrite), handler.UpdatePlayerHandler(deps.Services.PlayerService))
+		players.DELETE("/:id", can(entity.PermissionPlayersWrite), handler.DeletePlayerHandler(deps.Services.PlayerService))
+	}
+
+	// Staff
+	staff := authorized.Group("/staff")
+	{
+		staff.GET("", handler.GetAllStaffHandler(deps.Services.StaffService))
+		staff.GET("/:id", handler.GetStaffHandler(deps.Services.StaffService))
+		staff.POST("", can(entity.PermissionStaffWrite), handler.CreateStaffHandler(deps.Services.StaffService))
+		staff.PUT("/:id", can(entity.PermissionStaffWrite), handler.UpdateStaffHandler(deps.Services.StaffService))
+		staff.DELETE("/:id", can(entity.PermissionStaffWrite), handler.DeleteStaffHandler(deps.Services.StaffService))
 	}
 
 	// Match
@@ -65,9 +104,83 @@ func Router(r *gin.Engine, deps *APIDepedencies) {
 		matches.GET("", handler.GetAllMatchesHandler(deps.Services.MatchService))
 		matches.GET("/:id", handler.GetMatchHandler(deps.Services.MatchService))
 		matches.GET("/:id/report", handler.GetMatchReportHandler(deps.Services.MatchService))
-		matches.POST("", handler.CreateMatchHandler(deps.Services.MatchService))
-		matches.PUT("/:id", handler.UpdateMatchHandler(deps.Services.MatchService))
-		matches.DELETE("/:id", handler.DeleteMatchHandler(deps.Services.MatchService))
-		matches.POST("/:id/result", handler.SubmitResultHandler(deps.Services.MatchService))
+		matches.GET("/:id/prediction", handler.GetMatchPredictionHandler(deps.Services.MatchService))
+		matches.POST("", can(entity.PermissionMatchesWrite), handler.CreateMatchHandler(deps.Services.MatchService))
+		matches.PUT("/:id", can(entity.PermissionMatchesWrite), handler.UpdateMatchHandler(deps.Services.MatchService))
+		matches.DELETE("/:id", can(entity.PermissionMatchesWrite), handler.DeleteMatchHandler(deps.Services.MatchService))
+		matches.POST("/:id/result", can(entity.PermissionResultsWrite), handler.SubmitResultHandler(deps.Services.MatchService))
+		matches.GET("/:id/officials", handler.GetMatchOfficialsHandler(deps.Services.OfficialService))
+		matches.PUT("/:id/officials", can(entity.PermissionOfficialsWrite), handler.AssignMatchOfficialsHandler(deps.Services.OfficialService))
+	}
+
+	// Venue
+	venues := authorized.Group("/venues")
+	{
+		venues.GET("", handler.GetAllVenuesHandler(deps.Services.VenueService))
+		venues.GET("/:id", handler.GetVenueHandler(deps.Services.VenueService))
+		venues.GET("/:id/calendar", handler.GetVenueCalendarHandler(deps.Services.VenueService))
+		venues.POST("", can(entity.PermissionVenuesWrite), handler.CreateVenueHandler(deps.Services.VenueService))
+		venues.PUT("/:id", can(entity.PermissionVenuesWrite), handler.UpdateVenueHandler(deps.Services.VenueService))
+		venues.DELETE("/:id", can(entity.PermissionVenuesWrite), handler.DeleteVenueHandler(deps.Services.VenueService))
+		venues.POST("/:id/blocks", can(entity.PermissionVenuesWrite), handler.CreateVenueBlockHandler(deps.Services.VenueService))
+		venues.DELETE("/:id/blocks/:blockId", can(entity.PermissionVenuesWrite), handler.DeleteVenueBlockHandler(deps.Services.VenueService))
+	}
+
+	// Official
+	officials := authorized.Group("/officials")
+	{
+		officials.GET("", handler.GetAllOfficialsHandler(deps.Services.OfficialService))
+		officials.GET("/:id", handler.GetOfficialHandler(deps.Services.OfficialService))
+		officials.GET("/:id/report", handler.GetOfficialReportHandler(deps.Services.OfficialService))
+		officials.POST("", can(entity.PermissionOfficialsWrite), handler.CreateOfficialHandler(deps.Services.OfficialService))
+		officials.PUT("/:id", can(entity.PermissionOfficialsWrite), handler.UpdateOfficialHandler(deps.Services.OfficialService))
+		officials.DELETE("/:id", can(entity.PermissionOfficialsWrite), handler.DeleteOfficialHandler(deps.Services.OfficialService))
 	}
+
+	// Standings
+	standings := authorized.Group("/standings")
+	{
+		standings.GET("", handler.GetStandingsHandler(deps.Services.StandingsService))
+		standings.POST("/what-if", handler.GetWhatIfStandingsHandler(deps.Services.StandingsService))
+	}
+
+	// Ranking
+	authorized.GET("/rankings", handler.GetRankingsHandler(deps.Services.RatingService))
+
+	// User
+	users := authorized.Group("/users", can(entity.PermissionUsersManage))
+	{
+		users.GET("", handler.GetAllUsersHandler(deps.Services.UserService))
+		users.GET("/:id", handler.GetUserHandler(deps.Services.UserService))
+		users.PUT("/:id/role", handler.ChangeUserRoleHandler(deps.Services.UserService))
+		users.POST("/:id/disable", handler.DisableUserHandler(deps.Services.UserService))
+		users.POST("/:id/enable", handler.EnableUserHandler(deps.Services.UserService))
+		users.DELETE("/:id", handler.DeleteUserHandler(deps.Services.UserService))
+		users.DELETE("/:id/sessions", handler.RevokeUserSessionsHandler(deps.Services.UserService))
+		users.POST("/:id/unlock", handler.UnlockUserHandler(deps.Services.UserService))
+	}
+
+	// Invitation
+	invitations := authorized.Group("/invitations", can(entity.PermissionUsersManage))
+	{
+		invitations.POST("", handler.CreateInvitationHandler(deps.Services.UserService))
+		invitations.GET("", handler.GetInvitationsHandler(deps.Services.UserService))
+		invitations.DELETE("/:id", handler.DeleteInvitationHandler(deps.Services.UserService))
+	}
+
+	// API Key
+	apiKeys := authorized.Group("/api-keys", can(entity.PermissionUsersManage))
+	{
+		apiKeys.POST("", handler.CreateAPIKeyHandler(deps.Services.APIKeyService))
+		apiKeys.GET("", handler.GetAPIKeysHandler(deps.Services.APIKeyService))
+		apiKeys.DELETE("/:id", handler.DeleteAPIKeyHandler(deps.Services.APIKeyService))
+	}
+
+	// Me
+	authorized.POST("/me/feed-token", handler.RotateFeedTokenHandler(deps.Services.CalendarService))
+	authorized.GET("/me/sessions", handler.GetSessionsHandler(deps.Services.AuthService))
+	authorized.DELETE("/me/sessions/:id", handler.RevokeSessionHandler(deps.Services.AuthService))
+
+	// Simulation
+	authorized.POST("/simulations/season", handler.SimulateSeasonHandler(deps.Services.SimulationService))
 }
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go-test/lib/atomic"
+	"go-test/lib/i18n"
+	"go-test/lib/logger"
+	"go-test/lib/mailer"
+	"go-test/lib/provider"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+)
+
+type AccountConfig struct {
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+	// LinkBaseURL is the frontend the links in mails point to.
+	LinkBaseURL string
+}
+
+// AccountService handles the flows that prove ownership of an email through
+// a mailed link: password reset and email verification.
+type AccountService struct {
+	userRepo         UserRepository
+	accountTokenRepo AccountTokenRepository
+	sessionRepo      SessionRepository
+	feedTokenRepo    FeedTokenRepository
+	revocations      RevocationStore
+	unlocker         AccountUnlocker
+	mailLimiter      MailLimiter
+	atomicSession    atomic.AtomicSessionProvider
+	pswdProvider     provider.PasswordHashProvider
+	tokenProvider    provider.TokenProvider
+	mailer           mailer.Mailer
+	cfg              AccountConfig
+
+	// sending tracks the mails sent in the background, see Wait.
+	sending sync.WaitGroup
+}
+
+func NewAccountService(
+	userRepo UserRepository,
+	accountTokenRepo AccountTokenRepository,
+	sessionRepo SessionRepository,
+	feedTokenRepo FeedTokenRepository,
+	revocations RevocationStore,
+	unlocker AccountUnlocker,
+	mailLimiter MailLimiter,
+	atomicSession atomic.AtomicSessionProvider,
+	pswdProvider provider.PasswordHashProvider,
+	tokenProvider provider.TokenProvider,
+	mailer mailer.Mailer,
+	cfg AccountConfig,
+) *AccountService {
+	return &AccountService{
+		userRepo:         userRepo,
+		accountTokenRepo: accountTokenRepo,
+		sessionRepo:      sessionRepo,
+		feedTokenRepo:    feedTokenRepo,
+		revocations:      revocations,
+		unlocker:         unlocker,
+		mailLimiter:      mailLimiter,
+		atomicSession:    atomicSession,
+		pswdProvider:     pswdProvider,
+		tokenProvider:    tokenProvider,
+		mailer:           mailer,
+		cfg:              cfg,
+	}
+}
+
+// ForgotPassword mails a password reset link. It succeeds whether or not the
+// email belongs to a user, so it can not be used to find accounts: the mail is
+// sent in the background so the response time does not tell either, and the
+// per-email cooldown applies to unknown emails too.
+func (s *AccountService) ForgotPassword(ctx context.Context, req contract.ForgotPasswordRequest, lang string) error {
+	if err := s.mailLimiter.AllowMail(ctx, req.Email); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if user.DisabledAt != nil {
+		return nil
+	}
+
+	s.sendLinkAsync(ctx, user, entity.AccountTokenPasswordReset, lang)
+	return nil
+}
+
+// ResetPassword sets a new password with a reset token and signs the user out
+// of every session. Receiving the link also proves the email is theirs, so a
+// login lockout of the account is lifted too.
+func (s *AccountService) ResetPassword(ctx context.Context, req contract.ResetPasswordRequest) error {
+	token, err := s.accountTokenRepo.GetByTokenHash(ctx, provider.HashToken(req.Token), entity.AccountTokenPasswordReset)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInvalidResetToken
+		}
+		return err
+	}
+
+	user, err := s.userRepo.Get(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInvalidResetToken
+		}
+		return err
+	}
+
+	hashedPassword, err := s.pswdProvider.GenerateFromPassword(ctx, []byte(req.Password))
+	if err != nil {
+		logger.GetLogger(ctx).Error("GenerateFromPassword err: ", err)
+		return err
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.accountTokenRepo.MarkUsed(ctx, token.ID); err != nil {
+			return err
+		}
+		if err := s.userRepo.UpdatePassword(ctx, user.ID, string(hashedPassword)); err != nil {
+			return err
+		}
+		if user.EmailVerifiedAt == nil {
+			now := time.Now()
+			user.EmailVerifiedAt = &now
+			if err := s.userRepo.Update(ctx, &user); err != nil {
+				return err
+			}
+		}
+		return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, user.ID)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInvalidResetToken
+		}
+		logger.GetLogger(ctx).Error("ResetPassword err: ", err)
+		return err
+	}
+
+	if err := s.unlocker.Unlock(ctx, user.Email); err != nil {
+		logger.GetLogger(ctx).Error("Unlock err: ", err)
+	}
+
+	return nil
+}
+
+func (s *AccountService) VerifyEmail(ctx context.Context, req contract.VerifyEmailRequest) error {
+	token, err := s.accountTokenRepo.GetByTokenHash(ctx, provider.HashToken(req.Token), entity.AccountTokenEmailVerification)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInvalidVerificationToken
+		}
+		return err
+	}
+
+	user, err := s.userRepo.Get(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInvalidVerificationToken
+		}
+		return err
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.accountTokenRepo.MarkUsed(ctx, token.ID); err != nil {
+			return err
+		}
+		if user.EmailVerifiedAt != nil {
+			return nil
+		}
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+		return s.userRepo.Update(ctx, &user)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInvalidVerificationToken
+		}
+		logger.GetLogger(ctx).Error("VerifyEmail err: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// ResendVerification mails a new verification link to an unverified user.
+// Like ForgotPassword it does not reveal whether the email is registered.
+func (s *AccountService) ResendVerification(ctx context.Context, req contract.ResendVerificationRequest, lang string) error {
+	if err := s.mailLimiter.AllowMail(ctx, req.Email); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if user.EmailVerifiedAt != nil || user.DisabledAt != nil {
+		return nil
+	}
+
+	s.sendLinkAsync(ctx, user, entity.AccountTokenEmailVerification, lang)
+	return nil
+}
+
+// SendVerification mails an email verification link to the user in the
+// background.
+func (s *AccountService) SendVerification(ctx context.Context, user *entity.User, lang string) {
+	s.sendLinkAsync(ctx, *user, entity.AccountTokenEmailVerification, lang)
+}
+
+// Wait blocks until the mails being sent in the background are done, or ctx
+// is done. It is called on shutdown so queued links are not lost.
+func (s *AccountService) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.sending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sendLinkAsync runs sendLink in the background, detached from the request
+// so it is not cancelled when the response is written, and logs its error.
+func (s *AccountService) sendLinkAsync(ctx context.Context, user entity.User, purpose entity.AccountTokenPurpose, lang string) {
+	ctx = context.WithoutCancel(ctx)
+	s.sending.Add(1)
+	go func() {
+		defer s.sending.Done()
+		if err := s.sendLink(ctx, &user, purpose, lang); err != nil {
+			logger.GetLogger(ctx).Error("sendLink err: ", err)
+		}
+	}()
+}
+
+// sendLink issues a token for purpose, replacing any the user still has, and
+// mails the link with it in lang.
+func (s *AccountService) sendLink(ctx context.Context, user *entity.User, purpose entity.AccountTokenPurpose, lang string) error {
+	path, mailKey, ttl := "/reset-password", "mail_password_reset", s.cfg.PasswordResetTTL
+	if purpose == entity.AccountTokenEmailVerification {
+		path, mailKey, ttl = "/verify-email", "mail_email_verification", s.cfg.EmailVerificationTTL
+	}
+
+	token, err := s.tokenProvider.NewToken()
+	if err != nil {
+		return err
+	}
+
+	accountToken := &entity.AccountToken{
+		UserID:    user.ID,
+		Purpose:   purpose,
+		TokenHash: provider.HashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.accountTokenRepo.InvalidateByUser(ctx, user.ID, purpose); err != nil {
+			return err
+		}
+		_, err := s.accountTokenRepo.Create(ctx, accountToken)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	link := strings.TrimRight(s.cfg.LinkBaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: i18n.Translate(lang, mailKey+"_subject"),
+		Body: i18n.Translate(lang, mailKey+"_body", map[string]interface{}{
+			"Name":      user.Name,
+			"Link":      link,
+			"ExpiresAt": accountToken.ExpiresAt.Format("2006-01-02 15:04 MST"),
+		}),
+	})
+}
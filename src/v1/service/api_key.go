@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/netip"
+	"strings"
+	"time"
+
+	"go-test/lib/atomic"
+	"go-test/lib/logger"
+	"go-test/lib/provider"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+)
+
+// apiKeyPrefixLen is how much of a key is kept in clear to recognize it.
+const apiKeyPrefixLen = 8
+
+type APIKeyService struct {
+	apiKeyRepo    APIKeyRepository
+	tokenProvider provider.TokenProvider
+	atomicSession atomic.AtomicSessionProvider
+}
+
+func NewAPIKeyService(apiKeyRepo APIKeyRepository, tokenProvider provider.TokenProvider, atomicSession atomic.AtomicSessionProvider) *APIKeyService {
+	return &APIKeyService{
+		apiKeyRepo:    apiKeyRepo,
+		tokenProvider: tokenProvider,
+		atomicSession: atomicSession,
+	}
+}
+
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, actorID int64, req contract.CreateAPIKeyRequest) (*contract.APIKeyResponse, error) {
+	expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+	if err != nil || !expiresAt.After(time.Now()) {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	allowedIPs := make([]string, 0, len(req.AllowedIPs))
+	for _, entry := range req.AllowedIPs {
+		entry = strings.TrimSpace(entry)
+		if _, err := parseIPAllowlistEntry(entry); err != nil {
+			return nil, apperrors.ErrInvalidIPAllowlist
+		}
+		allowedIPs = append(allowedIPs, entry)
+	}
+
+	key, err := s.tokenProvider.NewToken()
+	if err != nil {
+		logger.GetLogger(ctx).Error("NewToken err: ", err)
+		return nil, err
+	}
+
+	apiKey := &entity.APIKey{
+		Name:       req.Name,
+		KeyHash:    provider.HashToken(key),
+		KeyPrefix:  key[:apiKeyPrefixLen],
+		Scopes:     req.Scopes,
+		AllowedIPs: allowedIPs,
+		CreatedBy:  actorID,
+		ExpiresAt:  expiresAt,
+	}
+
+	var apiKeyID int64
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		id, err := s.apiKeyRepo.Create(ctx, apiKey)
+		if err != nil {
+			return err
+		}
+		apiKeyID = id
+		return nil
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("CreateAPIKey err: ", err)
+		return nil, err
+	}
+
+	apiKey.ID = apiKeyID
+	apiKey.CreatedAt = time.Now()
+
+	response := apiKeyToResponse(apiKey)
+	response.Key = key
+	return response, nil
+}
+
+func (s *APIKeyService) GetAPIKeys(ctx context.Context) ([]contract.APIKeyResponse, error) {
+	apiKeys, err := s.apiKeyRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.APIKeyResponse, 0, len(apiKeys))
+	for _, k := range apiKeys {
+		response = append(response, *apiKeyToResponse(&k))
+	}
+
+	return response, nil
+}
+
+func (s *APIKeyService) DeleteAPIKey(ctx context.Context, id int64) error {
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.apiKeyRepo.Delete(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrAPIKeyNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Authenticate resolves an X-API-Key header sent from ip to the key's id and
+// scopes, and records that the key was used.
+func (s *APIKeyService) Authenticate(ctx context.Context, key, ip string) (int64, []string, error) {
+	apiKey, err := s.apiKeyRepo.GetByKeyHash(ctx, provider.HashToken(key))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil, apperrors.ErrInvalidAPIKey
+		}
+		return 0, nil, err
+	}
+
+	if !ipAllowed(apiKey.AllowedIPs, ip) {
+		return 0, nil, apperrors.ErrAPIKeyIPNotAllowed
+	}
+
+	// A failed write only loses the usage timestamp, so the request goes on.
+	if err := s.apiKeyRepo.TouchLastUsed(ctx, apiKey.ID); err != nil {
+		logger.GetLogger(ctx).Error("TouchLastUsed err: ", err)
+	}
+
+	return apiKey.ID, apiKey.Scopes, nil
+}
+
+// ipAllowed reports whether ip matches the allowlist. An empty allowlist
+// allows any address.
+func ipAllowed(allowlist []string, ip string) bool {
+	if len(allowlist) == 0 {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, entry := range allowlist {
+		prefix, err := parseIPAllowlistEntry(entry)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIPAllowlistEntry parses an IP or CIDR range. A single IP is the range
+// of just that address.
+func parseIPAllowlistEntry(entry string) (netip.Prefix, error) {
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func apiKeyToResponse(k *entity.APIKey) *contract.APIKeyResponse {
+	var lastUsedAt *string
+	if k.LastUsedAt != nil {
+		formatted := k.LastUsedAt.Format("2006-01-02 15:04:05")
+		lastUsedAt = &formatted
+	}
+
+	scopes := []string(k.Scopes)
+	if scopes == nil {
+		scopes = []string{}
+	}
+	allowedIPs := []string(k.AllowedIPs)
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+
+	return &contract.APIKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		KeyPrefix:  k.KeyPrefix,
+		Scopes:     scopes,
+		AllowedIPs: allowedIPs,
+		CreatedBy:  k.CreatedBy,
+		ExpiresAt:  k.ExpiresAt.Format("2006-01-02 15:04:05"),
+		LastUsedAt: lastUsedAt,
+		CreatedAt:  k.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -2,9 +2,9 @@ package service
 
 import (
 	"context"
-	"crypto/rsa"
 	"database/sql"
 	"errors"
+	"sync"
 	"time"
 
 	"go-test/lib/atomic"
@@ -17,31 +17,78 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+type AuthConfig struct {
+	// OpenRegistration allows anyone to register as a viewer. When false,
+	// users can only join through an invitation.
+	OpenRegistration bool
+	AccessTokenTTL   time.Duration
+	RefreshTokenTTL  time.Duration
+}
+
 type AuthService struct {
-	userRepo       UserRepository
-	atomicSession  atomic.AtomicSessionProvider
-	privateKey     *rsa.PrivateKey
-	pswdProvider   provider.PasswordHashProvider
-	pswdComparator provider.PasswordHashComparator
+	userRepo         UserRepository
+	userTeamRepo     UserTeamRepository
+	invitationRepo   InvitationRepository
+	sessionRepo      SessionRepository
+	refreshTokenRepo RefreshTokenRepository
+	revocations      RevocationStore
+	atomicSession    atomic.AtomicSessionProvider
+	signingKeys      provider.SigningKeyProvider
+	pswdProvider     provider.PasswordHashProvider
+	pswdComparator   provider.PasswordHashComparator
+	tokenProvider    provider.TokenProvider
+	uuidProvider     provider.UUIDProvider
+	verifier         EmailVerifier
+	loginLimiter     LoginLimiter
+	cfg              AuthConfig
+
+	dummyHashOnce sync.Once
+	dummyHash     []byte
 }
 
 func NewAuthService(
 	userRepo UserRepository,
+	userTeamRepo UserTeamRepository,
+	invitationRepo InvitationRepository,
+	sessionRepo SessionRepository,
+	refreshTokenRepo RefreshTokenRepository,
+	revocations RevocationStore,
 	atomicSession atomic.AtomicSessionProvider,
-	privateKey *rsa.PrivateKey,
+	signingKeys provider.SigningKeyProvider,
 	pswdProvider provider.PasswordHashProvider,
 	pswdComparator provider.PasswordHashComparator,
+	tokenProvider provider.TokenProvider,
+	uuidProvider provider.UUIDProvider,
+	verifier EmailVerifier,
+	loginLimiter LoginLimiter,
+	cfg AuthConfig,
 ) *AuthService {
 	return &AuthService{
-		userRepo:       userRepo,
-		atomicSession:  atomicSession,
-		privateKey:     privateKey,
-		pswdProvider:   pswdProvider,
-		pswdComparator: pswdComparator,
+		userRepo:         userRepo,
+		userTeamRepo:     userTeamRepo,
+		invitationRepo:   invitationRepo,
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		revocations:      revocations,
+		atomicSession:    atomicSession,
+		signingKeys:      signingKeys,
+		pswdProvider:     pswdProvider,
+		pswdComparator:   pswdComparator,
+		tokenProvider:    tokenProvider,
+		uuidProvider:     uuidProvider,
+		verifier:         verifier,
+		loginLimiter:     loginLimiter,
+		cfg:              cfg,
 	}
 }
 
-func (s *AuthService) Register(ctx context.Context, req contract.RegisterRequest) (*contract.AuthResponse, error) {
+// Register creates a viewer account and mails it an email verification link.
+// The account can log in once the email is verified.
+func (s *AuthService) Register(ctx context.Context, req contract.RegisterRequest, client contract.ClientInfo) (*contract.RegisterResponse, error) {
+	if !s.cfg.OpenRegistration {
+		return nil, apperrors.ErrRegistrationClosed
+	}
+
 	_, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err == nil {
 		return nil, apperrors.ErrEmailAlreadyExists
@@ -61,7 +108,7 @@ func (s *AuthService) Register(ctx context.Context, req contract.RegisterRequest
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: string(hashedPassword),
-		Role:     entity.UserRoleAdmin,
+		Role:     entity.UserRoleViewer,
 	}
 
 	var userID int64
@@ -78,18 +125,34 @@ func (s *AuthService) Register(ctx context.Context, req contract.RegisterRequest
 		return nil, err
 	}
 
-	token, err := s.generateToken(userID, string(entity.UserRoleAdmin))
-	if err != nil {
-		return nil, err
-	}
+	user.ID = userID
+
+	// The user can ask for a new link, so the mail is sent in the background
+	// and a failed one does not fail the registration.
+	s.verifier.SendVerification(ctx, user, client.Language)
 
-	return &contract.AuthResponse{Token: token}, nil
+	return &contract.RegisterResponse{
+		ID:    user.ID,
+		Name:  user.Name,
+		Email: user.Email,
+	}, nil
 }
 
-func (s *AuthService) Login(ctx context.Context, req contract.LoginRequest) (*contract.AuthResponse, error) {
+// Login checks the credentials once the login limiter allows another attempt
+// at the account from the client IP. Wrong passwords and unknown emails both
+// count as failures.
+func (s *AuthService) Login(ctx context.Context, req contract.LoginRequest, client contract.ClientInfo) (*contract.AuthResponse, error) {
+	if err := s.loginLimiter.Allow(ctx, req.Email, client.IPAddress); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
+			// Take as long as a wrong password, so the response time does not
+			// tell which emails are registered.
+			_ = s.pswdComparator.CompareHashAndPassword(ctx, s.dummyPasswordHash(ctx), []byte(req.Password))
+			s.loginLimiter.RecordFailure(ctx, req.Email, client.IPAddress)
 			return nil, apperrors.ErrInvalidCredentials
 		}
 		logger.GetLogger(ctx).Error("GetByEmail err: ", err)
@@ -98,24 +161,371 @@ func (s *AuthService) Login(ctx context.Context, req contract.LoginRequest) (*co
 
 	err = s.pswdComparator.CompareHashAndPassword(ctx, []byte(user.Password), []byte(req.Password))
 	if err != nil {
+		s.loginLimiter.RecordFailure(ctx, req.Email, client.IPAddress)
 		return nil, apperrors.ErrInvalidCredentials
 	}
+	s.loginLimiter.RecordSuccess(ctx, req.Email, client.IPAddress)
+
+	if user.DisabledAt != nil {
+		return nil, apperrors.ErrUserDisabled
+	}
+	if user.EmailVerifiedAt == nil {
+		return nil, apperrors.ErrEmailNotVerified
+	}
+
+	return s.startSession(ctx, &user, client)
+}
+
+// Refresh exchanges a refresh token for a new access token and the next
+// refresh token of the session. The previous access token of the session is
+// revoked, so a session only ever has one valid access token. A refresh token that was already used means
+// it was copied, so the whole session is revoked and both parties have to log
+// in again.
+func (s *AuthService) Refresh(ctx context.Context, req contract.RefreshRequest, client contract.ClientInfo) (*contract.AuthResponse, error) {
+	current, err := s.refreshTokenRepo.GetByTokenHash(ctx, provider.HashToken(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	session, err := s.sessionRepo.Get(ctx, current.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	if session.RevokedAt != nil {
+		return nil, apperrors.ErrInvalidRefreshToken
+	}
+
+	if current.UsedAt != nil {
+		s.revokeReusedSession(ctx, &session)
+		return nil, apperrors.ErrInvalidRefreshToken
+	}
+	if !current.ExpiresAt.After(time.Now()) {
+		return nil, apperrors.ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepo.Get(ctx, session.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	if user.DisabledAt != nil {
+		return nil, apperrors.ErrUserDisabled
+	}
+
+	teamIDs, err := s.teamIDsOf(ctx, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	renewed := session
+	s.renewAccess(&renewed, client)
+
+	var refreshToken string
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.refreshTokenRepo.MarkUsed(ctx, current.ID); err != nil {
+			return err
+		}
+		token, err := s.issueRefreshToken(ctx, &renewed)
+		if err != nil {
+			return err
+		}
+		refreshToken = token
+		if err := s.sessionRepo.Update(ctx, &renewed); err != nil {
+			return err
+		}
+		return s.revocations.Revoke(ctx, session.AccessJTI, session.AccessExpiresAt)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Another request used the token first.
+			s.revokeReusedSession(ctx, &session)
+			return nil, apperrors.ErrInvalidRefreshToken
+		}
+		logger.GetLogger(ctx).Error("Refresh err: ", err)
+		return nil, err
+	}
+
+	return s.authResponse(ctx, &user, teamIDs, &renewed, refreshToken)
+}
+
+// Logout revokes the session the access token was issued for, together with
+// the access token itself.
+func (s *AuthService) Logout(ctx context.Context, sessionID int64) error {
+	session, err := s.sessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrSessionNotFound
+		}
+		return err
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return revokeSession(ctx, s.sessionRepo, s.revocations, &session)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// Already revoked.
+		return nil
+	}
+	return err
+}
+
+// GetSessions lists the active sessions of the user. currentSessionID marks
+// the session of the calling token.
+func (s *AuthService) GetSessions(ctx context.Context, userID, currentSessionID int64) ([]contract.SessionResponse, error) {
+	sessions, err := s.sessionRepo.GetActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, contract.SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt.Format("2006-01-02 15:04:05"),
+			LastUsedAt: session.LastUsedAt.Format("2006-01-02 15:04:05"),
+			ExpiresAt:  session.ExpiresAt.Format("2006-01-02 15:04:05"),
+			Current:    session.ID == currentSessionID,
+		})
+	}
+
+	return response, nil
+}
+
+// RevokeSession signs the user out of one of their sessions, e.g. another
+// device.
+func (s *AuthService) RevokeSession(ctx context.Context, userID, id int64) error {
+	session, err := s.sessionRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrSessionNotFound
+		}
+		return err
+	}
+	if session.UserID != userID {
+		return apperrors.ErrSessionNotFound
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return revokeSession(ctx, s.sessionRepo, s.revocations, &session)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrSessionNotFound
+	}
+	return err
+}
+
+// AcceptInvitation creates the invited user with the role and teams of the
+// invitation and logs them in. The invitation is consumed in the same
+// transaction, so a token can only be used once.
+func (s *AuthService) AcceptInvitation(ctx context.Context, req contract.AcceptInvitationRequest, client contract.ClientInfo) (*contract.AuthResponse, error) {
+	invitation, err := s.invitationRepo.GetByTokenHash(ctx, provider.HashToken(req.Token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrInvalidInvitationToken
+		}
+		return nil, err
+	}
+
+	_, err = s.userRepo.GetByEmail(ctx, invitation.Email)
+	if err == nil {
+		return nil, apperrors.ErrEmailAlreadyExists
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		logger.GetLogger(ctx).Error("GetByEmail err: ", err)
+		return nil, err
+	}
 
-	token, err := s.generateToken(user.ID, string(user.Role))
+	hashedPassword, err := s.pswdProvider.GenerateFromPassword(ctx, []byte(req.Password))
 	if err != nil {
+		logger.GetLogger(ctx).Error("GenerateFromPassword err: ", err)
 		return nil, err
 	}
 
-	return &contract.AuthResponse{Token: token}, nil
+	// The invitation was sent to this email by an admin, so it counts as
+	// verified.
+	now := time.Now()
+	user := &entity.User{
+		Name:            req.Name,
+		Email:           invitation.Email,
+		Password:        string(hashedPassword),
+		Role:            invitation.Role,
+		EmailVerifiedAt: &now,
+	}
+
+	var userID int64
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.invitationRepo.MarkAccepted(ctx, invitation.ID); err != nil {
+			return err
+		}
+		id, err := s.userRepo.Create(ctx, user)
+		if err != nil {
+			return err
+		}
+		userID = id
+		for _, teamID := range invitation.TeamIDs {
+			if _, err := s.userTeamRepo.Create(ctx, &entity.UserTeam{UserID: userID, TeamID: teamID}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrInvalidInvitationToken
+		}
+		logger.GetLogger(ctx).Error("AcceptInvitation err: ", err)
+		return nil, err
+	}
+
+	user.ID = userID
+	return s.startSession(ctx, user, client)
 }
 
-func (s *AuthService) generateToken(userID int64, userType string) (string, error) {
+// startSession logs the user in on a new session.
+func (s *AuthService) startSession(ctx context.Context, user *entity.User, client contract.ClientInfo) (*contract.AuthResponse, error) {
+	teamIDs, err := s.teamIDsOf(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &entity.Session{UserID: user.ID}
+	s.renewAccess(session, client)
+
+	var refreshToken string
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		id, err := s.sessionRepo.Create(ctx, session)
+		if err != nil {
+			return err
+		}
+		session.ID = id
+
+		token, err := s.issueRefreshToken(ctx, session)
+		if err != nil {
+			return err
+		}
+		refreshToken = token
+		return nil
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("startSession err: ", err)
+		return nil, err
+	}
+
+	return s.authResponse(ctx, user, teamIDs, session, refreshToken)
+}
+
+// renewAccess prepares the session for a new access token and refresh token.
+func (s *AuthService) renewAccess(session *entity.Session, client contract.ClientInfo) {
+	now := time.Now()
+	session.AccessJTI = s.uuidProvider.NewUUID().String()
+	session.AccessExpiresAt = now.Add(s.cfg.AccessTokenTTL)
+	session.ExpiresAt = now.Add(s.cfg.RefreshTokenTTL)
+	session.LastUsedAt = now
+	session.UserAgent = client.UserAgent
+	session.IPAddress = client.IPAddress
+}
+
+// issueRefreshToken adds the next refresh token to the session's family and
+// returns it. Only its hash is stored.
+func (s *AuthService) issueRefreshToken(ctx context.Context, session *entity.Session) (string, error) {
+	token, err := s.tokenProvider.NewToken()
+	if err != nil {
+		logger.GetLogger(ctx).Error("NewToken err: ", err)
+		return "", err
+	}
+
+	_, err = s.refreshTokenRepo.Create(ctx, &entity.RefreshToken{
+		SessionID: session.ID,
+		TokenHash: provider.HashToken(token),
+		ExpiresAt: session.ExpiresAt,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *AuthService) revokeReusedSession(ctx context.Context, session *entity.Session) {
+	logger.GetLogger(ctx).Warn("refresh token reused, revoking session ", session.ID)
+
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return revokeSession(ctx, s.sessionRepo, s.revocations, session)
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.GetLogger(ctx).Error("Revoke session err: ", err)
+	}
+}
+
+// teamIDsOf returns the teams a team-scoped user manages, nil for other roles.
+// dummyPasswordHash returns the hash of a random password, made like the
+// hashes of real passwords, to check passwords for unknown emails against.
+func (s *AuthService) dummyPasswordHash(ctx context.Context) []byte {
+	s.dummyHashOnce.Do(func() {
+		password, err := s.tokenProvider.NewToken()
+		if err != nil {
+			logger.GetLogger(ctx).Error("NewToken err: ", err)
+		}
+		s.dummyHash, err = s.pswdProvider.GenerateFromPassword(ctx, []byte(password))
+		if err != nil {
+			logger.GetLogger(ctx).Error("GenerateFromPassword err: ", err)
+		}
+	})
+	return s.dummyHash
+}
+
+func (s *AuthService) teamIDsOf(ctx context.Context, user *entity.User) ([]int64, error) {
+	if !user.Role.IsTeamScoped() {
+		return nil, nil
+	}
+	return s.userTeamRepo.GetTeamIDsByUser(ctx, user.ID)
+}
+
+func (s *AuthService) authResponse(ctx context.Context, user *entity.User, teamIDs []int64, session *entity.Session, refreshToken string) (*contract.AuthResponse, error) {
+	token, err := s.generateToken(ctx, user.ID, string(user.Role), teamIDs, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contract.AuthResponse{
+		Token:        token,
+		ExpiresAt:    session.AccessExpiresAt.Format("2006-01-02 15:04:05"),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// generateToken signs the session's access token for the user. teamIDs is
+// only set for team-scoped roles and limits their writes to those teams; sid
+// is the session the token belongs to and jti lets it be revoked. The kid
+// header names the keyring key it is signed with.
+func (s *AuthService) generateToken(ctx context.Context, userID int64, userType string, teamIDs []int64, session *entity.Session) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id":   userID,
 		"user_type": userType,
-		"exp":       time.Now().Add(time.Hour * 24 * 7).Unix(),
+		"sid":       session.ID,
+		"jti":       session.AccessJTI,
+		"exp":       session.AccessExpiresAt.Unix(),
+	}
+	if teamIDs != nil {
+		claims["team_ids"] = teamIDs
+	}
+
+	kid, key, err := s.signingKeys.SigningKey(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-	return token.SignedString(s.privateKey)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
 }
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-test/lib/atomic"
+	"go-test/lib/ical"
+	"go-test/lib/logger"
+	"go-test/lib/provider"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+)
+
+// calendarMatchDuration is the length of a fixture in calendar feeds.
+const calendarMatchDuration = 2 * time.Hour
+
+type CalendarConfig struct {
+	// ServiceName is used in PRODID and as the domain part of event UIDs,
+	// which must never change for a given match.
+	ServiceName string
+}
+
+type CalendarService struct {
+	feedTokenRepo FeedTokenRepository
+	matchRepo     MatchRepository
+	teamRepo      TeamRepository
+	tokenProvider provider.TokenProvider
+	atomicSession atomic.AtomicSessionProvider
+	cfg           CalendarConfig
+}
+
+func NewCalendarService(
+	feedTokenRepo FeedTokenRepository,
+	matchRepo MatchRepository,
+	teamRepo TeamRepository,
+	tokenProvider provider.TokenProvider,
+	atomicSession atomic.AtomicSessionProvider,
+	cfg CalendarConfig,
+) *CalendarService {
+	return &CalendarService{
+		feedTokenRepo: feedTokenRepo,
+		matchRepo:     matchRepo,
+		teamRepo:      teamRepo,
+		tokenProvider: tokenProvider,
+		atomicSession: atomicSession,
+		cfg:           cfg,
+	}
+}
+
+// RotateFeedToken issues a new feed token for the user and revokes the old
+// one. The token is only returned here; the database keeps its hash.
+func (s *CalendarService) RotateFeedToken(ctx context.Context, userID int64) (*contract.FeedTokenResponse, error) {
+	token, err := s.tokenProvider.NewToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.feedTokenRepo.DeleteByUser(ctx, userID); err != nil {
+			return err
+		}
+		_, err := s.feedTokenRepo.Create(ctx, &entity.FeedToken{
+			UserID:    userID,
+			TokenHash: provider.HashToken(token),
+		})
+		return err
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("RotateFeedToken err: ", err)
+		return nil, err
+	}
+
+	return &contract.FeedTokenResponse{
+		Token:         token,
+		AllMatchesURL: "/v1/matches/fixtures.ics?token=" + token,
+		TeamFeedURL:   "/v1/teams/{id}/fixtures.ics?token=" + token,
+	}, nil
+}
+
+func (s *CalendarService) GetAllFixturesFeed(ctx context.Context, token string) ([]byte, error) {
+	if err := s.authenticateFeed(ctx, token); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.matchRepo.GetFeed(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildCalendar("All fixtures", entries), nil
+}
+
+func (s *CalendarService) GetTeamFixturesFeed(ctx context.Context, token string, teamID int64) ([]byte, error) {
+	if err := s.authenticateFeed(ctx, token); err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.Get(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	entries, err := s.matchRepo.GetFeed(ctx, &teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildCalendar(team.Name+" fixtures", entries), nil
+}
+
+func (s *CalendarService) authenticateFeed(ctx context.Context, token string) error {
+	if token == "" {
+		return apperrors.ErrInvalidToken
+	}
+
+	if _, err := s.feedTokenRepo.GetByTokenHash(ctx, provider.HashToken(token)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInvalidToken
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *CalendarService) buildCalendar(name string, entries []entity.MatchFeedEntry) []byte {
+	// DTSTAMP is when the feed was generated; LAST-MODIFIED is the match.
+	now := time.Now()
+	cal := ical.Calendar{
+		ProdID: fmt.Sprintf("-//%s//Fixtures//EN", s.cfg.ServiceName),
+		Name:   name,
+		Events: make([]ical.Event, 0, len(entries)),
+	}
+	for _, e := range entries {
+		cal.Events = append(cal.Events, s.matchEvent(e, now))
+	}
+	return cal.Marshal()
+}
+
+// matchEvent maps a match to a calendar event. Rescheduling bumps the match
+// sequence, so calendar apps replace the old entry; deleted matches stay in
+// the feed as cancelled events so they disappear from subscribers' calendars.
+func (s *CalendarService) matchEvent(m entity.MatchFeedEntry, stamp time.Time) ical.Event {
+	status := ical.StatusConfirmed
+	description := ""
+	if m.Status == entity.MatchStatusCompleted && m.HomeScore != nil && m.AwayScore != nil {
+		description = fmt.Sprintf("Final score: %s %d - %d %s", m.HomeTeamName, *m.HomeScore, *m.AwayScore, m.AwayTeamName)
+	}
+	if m.DeletedAt != nil {
+		status = ical.StatusCancelled
+		description = "This match has been cancelled."
+	}
+
+	return ical.Event{
+		UID:          fmt.Sprintf("match-%d@%s", m.ID, s.cfg.ServiceName),
+		Sequence:     m.Sequence,
+		Stamp:        stamp,
+		LastModified: localTimestamp(m.UpdatedAt),
+		Start:        m.KickoffAt,
+		End:          m.KickoffAt.Add(calendarMatchDuration),
+		Summary:      fmt.Sprintf("%s vs %s", m.HomeTeamName, m.AwayTeamName),
+		Description:  description,
+		Location:     matchLocation(m),
+		Status:       status,
+	}
+}
+
+// matchLocation is the venue of the match, or the home team city for matches
+// without one.
+func matchLocation(m entity.MatchFeedEntry) string {
+	if m.VenueName == nil {
+		return m.HomeTeamCity
+	}
+	if m.VenueCity == nil || *m.VenueCity == "" {
+		return *m.VenueName
+	}
+	return *m.VenueName + ", " + *m.VenueCity
+}
+
+// localTimestamp reinterprets a TIMESTAMP column, which the driver returns as
+// UTC, as the server-local wall-clock time it was written with.
+func localTimestamp(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.Local)
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go-test/src/entity"
+)
+
+// fakeMatchRepo serves matches from memory. Methods a test does not set up
+// panic through the nil embedded interface.
+type fakeMatchRepo struct {
+	MatchRepository
+	matches []entity.Match
+}
+
+func (r *fakeMatchRepo) Get(ctx context.Context, id int64) (entity.Match, error) {
+	for _, m := range r.matches {
+		if m.ID == id {
+			return m, nil
+		}
+	}
+	return entity.Match{}, sql.ErrNoRows
+}
+
+func (r *fakeMatchRepo) GetCompleted(ctx context.Context) ([]entity.Match, error) {
+	var completed []entity.Match
+	for _, m := range r.matches {
+		if m.Status == entity.MatchStatusCompleted {
+			completed = append(completed, m)
+		}
+	}
+	return completed, nil
+}
+
+func (r *fakeMatchRepo) GetCompletedInRange(ctx context.Context, from, to *time.Time) ([]entity.Match, error) {
+	return r.inRange(entity.MatchStatusCompleted, from, to), nil
+}
+
+func (r *fakeMatchRepo) GetScheduledInRange(ctx context.Context, from, to *time.Time) ([]entity.Match, error) {
+	return r.inRange(entity.MatchStatusScheduled, from, to), nil
+}
+
+func (r *fakeMatchRepo) inRange(status entity.MatchStatus, from, to *time.Time) []entity.Match {
+	var matches []entity.Match
+	for _, m := range r.matches {
+		if m.Status != status || (from != nil && m.MatchDate.Before(*from)) || (to != nil && m.MatchDate.After(*to)) {
+			continue
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+type fakeTeamRepo struct {
+	TeamRepository
+	teams []entity.Team
+}
+
+func (r *fakeTeamRepo) Get(ctx context.Context, id int64) (entity.Team, error) {
+	for _, t := range r.teams {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return entity.Team{}, sql.ErrNoRows
+}
+
+func (r *fakeTeamRepo) GetList(ctx context.Context) ([]entity.Team, error) {
+	return r.teams, nil
+}
+
+func testTeam(id int64, name string) entity.Team {
+	return entity.Team{ModelID: entity.ModelID{ID: id}, Name: name}
+}
+
+func completedMatch(id, homeTeamID, awayTeamID int64, date string, homeScore, awayScore int) entity.Match {
+	m := scheduledMatch(id, homeTeamID, awayTeamID, date)
+	m.Status = entity.MatchStatusCompleted
+	m.HomeScore = &homeScore
+	m.AwayScore = &awayScore
+	return m
+}
+
+func scheduledMatch(id, homeTeamID, awayTeamID int64, date string) entity.Match {
+	matchDate, _ := time.Parse("2006-01-02", date)
+	return entity.Match{
+		ModelID:    entity.ModelID{ID: id},
+		HomeTeamID: homeTeamID,
+		AwayTeamID: awayTeamID,
+		MatchDate:  matchDate,
+		MatchTime:  "15:00",
+		KickoffAt:  matchDate.Add(15 * time.Hour),
+		Timezone:   "UTC",
+		Status:     entity.MatchStatusScheduled,
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"go-test/src/entity"
 )
@@ -10,14 +11,112 @@ type UserRepository interface {
 	Create(ctx context.Context, data *entity.User) (int64, error)
 	Get(ctx context.Context, id int64) (entity.User, error)
 	GetByEmail(ctx context.Context, email string) (entity.User, error)
+	GetList(ctx context.Context) ([]entity.User, error)
+	Update(ctx context.Context, data *entity.User) error
+	UpdatePassword(ctx context.Context, id int64, password string) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type AccountTokenRepository interface {
+	Create(ctx context.Context, data *entity.AccountToken) (int64, error)
+	GetByTokenHash(ctx context.Context, tokenHash string, purpose entity.AccountTokenPurpose) (entity.AccountToken, error)
+	MarkUsed(ctx context.Context, id int64) error
+	InvalidateByUser(ctx context.Context, userID int64, purpose entity.AccountTokenPurpose) error
+}
+
+// EmailVerifier mails an email verification link to a new user in the
+// background.
+type EmailVerifier interface {
+	SendVerification(ctx context.Context, user *entity.User, lang string)
+}
+
+type SessionRepository interface {
+	Create(ctx context.Context, data *entity.Session) (int64, error)
+	Get(ctx context.Context, id int64) (entity.Session, error)
+	GetActiveByUser(ctx context.Context, userID int64) ([]entity.Session, error)
+	Update(ctx context.Context, data *entity.Session) error
+	Revoke(ctx context.Context, id int64) error
+	RevokeByUser(ctx context.Context, userID int64) ([]entity.Session, error)
+}
+
+type RevokedTokenRepository interface {
+	Create(ctx context.Context, data *entity.RevokedToken) error
+	GetActive(ctx context.Context) ([]entity.RevokedToken, error)
+}
+
+// RevocationStore rejects access tokens by jti before they expire, see
+// TokenRevocationStore.
+type RevocationStore interface {
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+}
+
+// LoginAttemptStore counts failed logins per key, an account or an IP, see
+// LoginThrottle. Get returns sql.ErrNoRows for a key without failures.
+type LoginAttemptStore interface {
+	Get(ctx context.Context, key string) (entity.LoginAttempt, error)
+	RecordFailure(ctx context.Context, key string, window time.Duration) (entity.LoginAttempt, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Forgive(ctx context.Context, key string) error
+	Reset(ctx context.Context, key string) error
+}
+
+// LoginLimiter rejects logins after too many failures, see LoginThrottle.
+type LoginLimiter interface {
+	Allow(ctx context.Context, email, ip string) error
+	RecordFailure(ctx context.Context, email, ip string)
+	RecordSuccess(ctx context.Context, email, ip string)
+}
+
+// AccountUnlocker lifts the login lockout of an account, see LoginThrottle.
+type AccountUnlocker interface {
+	Unlock(ctx context.Context, email string) error
+}
+
+// MailLimiter rejects password reset and verification mails requested too
+// often for one email, see LoginThrottle.
+type MailLimiter interface {
+	AllowMail(ctx context.Context, email string) error
+}
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, data *entity.RefreshToken) (int64, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (entity.RefreshToken, error)
+	MarkUsed(ctx context.Context, id int64) error
+}
+
+type InvitationRepository interface {
+	Create(ctx context.Context, data *entity.Invitation) (int64, error)
+	Get(ctx context.Context, id int64) (entity.Invitation, error)
+	GetList(ctx context.Context) ([]entity.Invitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (entity.Invitation, error)
+	MarkAccepted(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, data *entity.APIKey) (int64, error)
+	GetList(ctx context.Context) ([]entity.APIKey, error)
+	GetByKeyHash(ctx context.Context, keyHash string) (entity.APIKey, error)
+	TouchLastUsed(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type UserTeamRepository interface {
+	Create(ctx context.Context, data *entity.UserTeam) (int64, error)
+	GetTeamIDsByUser(ctx context.Context, userID int64) ([]int64, error)
+	Delete(ctx context.Context, userID, teamID int64) error
+	DeleteByUser(ctx context.Context, userID int64) error
 }
 
 type TeamRepository interface {
 	Create(ctx context.Context, data *entity.Team) (int64, error)
 	Get(ctx context.Context, id int64) (entity.Team, error)
 	GetList(ctx context.Context) ([]entity.Team, error)
+	GetAsOf(ctx context.Context, id int64, asOf time.Time) (entity.Team, error)
+	GetListAsOf(ctx context.Context, asOf time.Time) ([]entity.Team, error)
 	Update(ctx context.Context, data *entity.Team) error
 	Delete(ctx context.Context, id int64) error
+	ClearHomeVenue(ctx context.Context, venueID int64) error
 }
 
 type PlayerRepository interface {
@@ -28,13 +127,39 @@ type PlayerRepository interface {
 	Update(ctx context.Context, data *entity.Player) error
 	Delete(ctx context.Context, id int64) error
 	IsJerseyTaken(ctx context.Context, teamID int64, jerseyNumber int, excludePlayerID int64) (bool, error)
+	GetStatSummary(ctx context.Context, playerID int64, from, to, asOf *time.Time) (entity.PlayerStatSummary, error)
+	GetStatsByTeam(ctx context.Context, playerID int64, from, to, asOf *time.Time) ([]entity.PlayerTeamStat, error)
+	GetStatsBySeason(ctx context.Context, playerID int64, from, to, asOf *time.Time) ([]entity.PlayerSeasonStat, error)
+	GetLeaderboard(ctx context.Context, metric string, from, to *time.Time, limit int, asOf *time.Time) ([]entity.PlayerLeaderboardEntry, error)
+}
+
+type StaffRepository interface {
+	Create(ctx context.Context, data *entity.StaffMember) (int64, error)
+	Get(ctx context.Context, id int64) (entity.StaffMember, error)
+	GetList(ctx context.Context) ([]entity.StaffMember, error)
+	GetByTeamOnDate(ctx context.Context, teamID int64, date time.Time) ([]entity.StaffMember, error)
+	GetHistoryByTeam(ctx context.Context, teamID int64, before time.Time) ([]entity.StaffMember, error)
+	HasOverlappingTenure(ctx context.Context, teamID int64, role entity.StaffRole, start time.Time, end *time.Time, excludeID int64) (bool, error)
+	Update(ctx context.Context, data *entity.StaffMember) error
+	Delete(ctx context.Context, id int64) error
 }
 
 type MatchRepository interface {
 	Create(ctx context.Context, data *entity.Match) (int64, error)
 	Get(ctx context.Context, id int64) (entity.Match, error)
 	GetList(ctx context.Context) ([]entity.Match, error)
-	GetCompletedByTeam(ctx context.Context, teamID int64, untilDate string) ([]entity.MatchWinStat, error)
+	GetCompleted(ctx context.Context) ([]entity.Match, error)
+	GetCompletedFrom(ctx context.Context, from time.Time) ([]entity.Match, error)
+	GetCompletedInRange(ctx context.Context, from, to *time.Time) ([]entity.Match, error)
+	GetCompletedAsOf(ctx context.Context, asOf time.Time) ([]entity.Match, error)
+	GetScheduledInRange(ctx context.Context, from, to *time.Time) ([]entity.Match, error)
+	GetFeed(ctx context.Context, teamID *int64) ([]entity.MatchFeedEntry, error)
+	GetVenueFixturesInRange(ctx context.Context, venueID int64, from, to time.Time, excludeMatchID int64) ([]entity.Match, error)
+	GetTeamFixturesInRange(ctx context.Context, homeTeamID, awayTeamID int64, from, to time.Time, excludeMatchID int64) ([]entity.Match, error)
+	GetCompletedByTeam(ctx context.Context, teamID int64, untilDate string, asOf *time.Time) ([]entity.MatchWinStat, error)
+	GetTeamStats(ctx context.Context, teamID int64, from, to, asOf *time.Time) (entity.TeamStat, error)
+	GetHeadToHead(ctx context.Context, teamID, opponentID int64, before, asOf *time.Time, excludeMatchID int64) ([]entity.Match, error)
+	GetHeadToHeadSummary(ctx context.Context, teamID, opponentID int64, before, asOf *time.Time, excludeMatchID int64) (entity.HeadToHeadStat, error)
 	Update(ctx context.Context, data *entity.Match) error
 	SetResult(ctx context.Context, data *entity.Match) error
 	Delete(ctx context.Context, id int64) error
@@ -43,5 +168,59 @@ type MatchRepository interface {
 type GoalRepository interface {
 	Create(ctx context.Context, data *entity.Goal) (int64, error)
 	GetByMatch(ctx context.Context, matchID int64) ([]entity.Goal, error)
+	GetHeadToHeadScorers(ctx context.Context, teamID, opponentID int64, limit int, asOf *time.Time) ([]entity.GoalScorerStat, error)
 	DeleteByMatch(ctx context.Context, matchID int64) error
 }
+
+type CardRepository interface {
+	Create(ctx context.Context, data *entity.Card) (int64, error)
+	GetByMatch(ctx context.Context, matchID int64) ([]entity.Card, error)
+	DeleteByMatch(ctx context.Context, matchID int64) error
+}
+
+type OfficialRepository interface {
+	Create(ctx context.Context, data *entity.Official) (int64, error)
+	Get(ctx context.Context, id int64) (entity.Official, error)
+	GetList(ctx context.Context) ([]entity.Official, error)
+	Update(ctx context.Context, data *entity.Official) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type MatchOfficialRepository interface {
+	Create(ctx context.Context, data *entity.MatchOfficial) (int64, error)
+	GetByMatch(ctx context.Context, matchID int64) ([]entity.MatchOfficialDetail, error)
+	CountByOfficialInRange(ctx context.Context, officialID int64, from, to time.Time, excludeMatchID int64) (int, error)
+	GetAssignmentsByOfficial(ctx context.Context, officialID int64, from, to *time.Time) ([]entity.OfficialAssignment, error)
+	DeleteByMatch(ctx context.Context, matchID int64) error
+}
+
+type RatingRepository interface {
+	Create(ctx context.Context, data *entity.TeamRating) (int64, error)
+	GetLatestBefore(ctx context.Context, before time.Time) ([]entity.TeamRating, error)
+	GetHistoryByTeam(ctx context.Context, teamID int64) ([]entity.TeamRatingHistory, error)
+	GetRankings(ctx context.Context, initialRating float64, asOf *time.Time) ([]entity.TeamRanking, error)
+	DeleteAll(ctx context.Context) error
+	DeleteFrom(ctx context.Context, from time.Time) error
+	Lock(ctx context.Context) error
+}
+
+type VenueRepository interface {
+	Create(ctx context.Context, data *entity.Venue) (int64, error)
+	Get(ctx context.Context, id int64) (entity.Venue, error)
+	GetList(ctx context.Context) ([]entity.Venue, error)
+	Update(ctx context.Context, data *entity.Venue) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type VenueBlockRepository interface {
+	Create(ctx context.Context, data *entity.VenueBlock) (int64, error)
+	Get(ctx context.Context, id int64) (entity.VenueBlock, error)
+	GetByVenueInRange(ctx context.Context, venueID int64, from, to time.Time) ([]entity.VenueBlock, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type FeedTokenRepository interface {
+	Create(ctx context.Context, data *entity.FeedToken) (int64, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (entity.FeedToken, error)
+	DeleteByUser(ctx context.Context, userID int64) error
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"math/big"
+
+	"go-test/lib/provider"
+	"go-test/src/v1/contract"
+)
+
+type KeyService struct {
+	keys provider.VerificationKeyProvider
+}
+
+func NewKeyService(keys provider.VerificationKeyProvider) *KeyService {
+	return &KeyService{keys: keys}
+}
+
+// GetJWKS publishes every key a token may currently be verified with,
+// including pending keys so clients know them before they sign anything.
+func (s *KeyService) GetJWKS(ctx context.Context) contract.JWKSResponse {
+	entries := s.keys.PublicKeys(ctx)
+
+	keys := make([]contract.JWK, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, contract.JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: entry.Kid,
+			N:   base64.RawURLEncoding.EncodeToString(entry.Key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(entry.Key.E)).Bytes()),
+		})
+	}
+
+	return contract.JWKSResponse{Keys: keys}
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	i18n_err "go-test/lib/i18n/errors"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+type LoginThrottleConfig struct {
+	// MaxFailures failed logins to an account within FailureWindow lock it
+	// for Lockout.
+	MaxFailures int
+	// IPMaxFailures failed logins from one IP within FailureWindow, to any
+	// account, lock the IP for Lockout.
+	IPMaxFailures int
+	FailureWindow time.Duration
+	Lockout       time.Duration
+	// Delay is how long an account has to wait after its first failed
+	// login. It doubles with every further failure, up to Lockout.
+	Delay time.Duration
+	// MailCooldown is how long an email has to wait between two password
+	// reset or verification links.
+	MailCooldown time.Duration
+}
+
+// LoginThrottle slows down password guessing. Each failed login to an account
+// makes its next attempt wait longer, and too many failures lock the account
+// for a while; an IP trying many accounts, as in credential stuffing, is
+// locked the same way. Failures are kept in a LoginAttemptStore so every
+// instance sees them.
+//
+// An attempt is counted as a failure as soon as it is allowed, before the
+// password is checked, so parallel guesses can not all get in before the
+// first one fails. RecordSuccess takes the attempt back.
+type LoginThrottle struct {
+	store LoginAttemptStore
+	cfg   LoginThrottleConfig
+}
+
+func NewLoginThrottle(store LoginAttemptStore, cfg LoginThrottleConfig) *LoginThrottle {
+	return &LoginThrottle{
+		store: store,
+		cfg:   cfg,
+	}
+}
+
+// Allow returns ErrAccountLocked or ErrTooManyLoginAttempts, with
+// contract.LoginRetryDetails, when a login to email from ip has to wait, and
+// otherwise counts the attempt.
+func (t *LoginThrottle) Allow(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	ipKey, accountKey := loginIPKey(ip), loginAccountKey(email)
+
+	attempt, err := t.get(ctx, ipKey)
+	if err != nil {
+		return err
+	}
+	if attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+		return retryError(apperrors.ErrTooManyLoginAttempts, attempt.LockedUntil.Sub(now))
+	}
+
+	seen, err := t.get(ctx, accountKey)
+	if err != nil {
+		return err
+	}
+	if seen.LockedUntil != nil && seen.LockedUntil.After(now) {
+		return retryError(apperrors.ErrAccountLocked, seen.LockedUntil.Sub(now))
+	}
+	if seen.Failures > 0 && now.Sub(seen.LastFailedAt) < t.cfg.FailureWindow {
+		next := seen.LastFailedAt.Add(t.delay(seen.Failures))
+		if next.After(now) {
+			return retryError(apperrors.ErrTooManyLoginAttempts, next.Sub(now))
+		}
+	} else {
+		seen.Failures = 0
+	}
+
+	if _, err := t.admit(ctx, ipKey, t.cfg.IPMaxFailures, apperrors.ErrTooManyLoginAttempts); err != nil {
+		return err
+	}
+	attempt, err = t.admit(ctx, accountKey, t.cfg.MaxFailures, apperrors.ErrAccountLocked)
+	if err != nil {
+		return err
+	}
+	// Another attempt at the account was counted since it was read, so both
+	// passed the delay at once. Only the first one gets in.
+	if attempt.Failures > seen.Failures+1 {
+		return retryError(apperrors.ErrTooManyLoginAttempts, t.delay(attempt.Failures-1))
+	}
+
+	return nil
+}
+
+// RecordFailure locks the account or the IP of a failed login to email from
+// ip, whether or not the account exists, once it reached its limit. The
+// failure itself was counted by Allow.
+func (t *LoginThrottle) RecordFailure(ctx context.Context, email, ip string) {
+	t.lockIfExhausted(ctx, loginAccountKey(email), t.cfg.MaxFailures)
+	t.lockIfExhausted(ctx, loginIPKey(ip), t.cfg.IPMaxFailures)
+}
+
+// RecordSuccess forgets the failed logins to email and takes back the attempt
+// counted for ip. Earlier failures from the IP are kept, otherwise one valid
+// credential would hide guessing at others.
+func (t *LoginThrottle) RecordSuccess(ctx context.Context, email, ip string) {
+	if err := t.store.Reset(ctx, loginAccountKey(email)); err != nil {
+		logger.GetLogger(ctx).Error("Reset login attempts err: ", err)
+	}
+	if err := t.store.Forgive(ctx, loginIPKey(ip)); err != nil {
+		logger.GetLogger(ctx).Error("Forgive login attempt err: ", err)
+	}
+}
+
+// AllowMail returns ErrTooManyMailRequests, with contract.LoginRetryDetails,
+// when a link was requested for email less than MailCooldown ago, and
+// otherwise starts a new cooldown. It does not look at the account, so it
+// answers the same for registered and unknown emails.
+func (t *LoginThrottle) AllowMail(ctx context.Context, email string) error {
+	now := time.Now()
+	key := loginMailKey(email)
+
+	attempt, err := t.get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+		return retryError(apperrors.ErrTooManyMailRequests, attempt.LockedUntil.Sub(now))
+	}
+
+	if _, err := t.store.RecordFailure(ctx, key, t.cfg.FailureWindow); err != nil {
+		return err
+	}
+	return t.store.Lock(ctx, key, now.Add(t.cfg.MailCooldown))
+}
+
+// Unlock lifts the lockout of the account with email and forgets its failed
+// logins.
+func (t *LoginThrottle) Unlock(ctx context.Context, email string) error {
+	return t.store.Reset(ctx, loginAccountKey(email))
+}
+
+// admit counts an attempt at key. The count is taken atomically by the
+// store, so an attempt beyond maxFailures, or one that arrives while another
+// is locking the key, is rejected with lockedErr.
+func (t *LoginThrottle) admit(ctx context.Context, key string, maxFailures int, lockedErr i18n_err.I18nError) (entity.LoginAttempt, error) {
+	attempt, err := t.store.RecordFailure(ctx, key, t.cfg.FailureWindow)
+	if err != nil {
+		return entity.LoginAttempt{}, err
+	}
+
+	now := time.Now()
+	if attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+		return attempt, retryError(lockedErr, attempt.LockedUntil.Sub(now))
+	}
+	if attempt.Failures > maxFailures {
+		t.lock(ctx, key)
+		return attempt, retryError(lockedErr, t.cfg.Lockout)
+	}
+
+	return attempt, nil
+}
+
+func (t *LoginThrottle) lockIfExhausted(ctx context.Context, key string, maxFailures int) {
+	attempt, err := t.get(ctx, key)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Get login attempt err: ", err)
+		return
+	}
+	if attempt.Failures < maxFailures {
+		return
+	}
+	t.lock(ctx, key)
+}
+
+func (t *LoginThrottle) lock(ctx context.Context, key string) {
+	logger.GetLogger(ctx).Warn("login locked after too many failures: ", key)
+	if err := t.store.Lock(ctx, key, time.Now().Add(t.cfg.Lockout)); err != nil {
+		logger.GetLogger(ctx).Error("Lock login attempt err: ", err)
+	}
+}
+
+func (t *LoginThrottle) get(ctx context.Context, key string) (entity.LoginAttempt, error) {
+	attempt, err := t.store.Get(ctx, key)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return entity.LoginAttempt{}, err
+	}
+	return attempt, nil
+}
+
+// delay is how long to wait after the given number of failures.
+func (t *LoginThrottle) delay(failures int) time.Duration {
+	if t.cfg.Delay <= 0 {
+		return 0
+	}
+	// Past 2^20 the delay is longer than any sensible lockout anyway.
+	d := t.cfg.Delay * time.Duration(math.Pow(2, float64(min(failures-1, 20))))
+	return min(d, t.cfg.Lockout)
+}
+
+func retryError(err i18n_err.I18nError, wait time.Duration) error {
+	return i18n_err.WithDetails(err, contract.LoginRetryDetails{
+		RetryAfter: int(math.Ceil(wait.Seconds())),
+	})
+}
+
+func loginAccountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func loginIPKey(ip string) string {
+	return "ip:" + ip
+}
+
+func loginMailKey(email string) string {
+	return "mail:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// MemoryLoginAttemptStore keeps failed logins in the process. It suits a
+// single instance or development; failures are lost on restart and are not
+// shared between instances, use the database store for those.
+type MemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	attempts map[string]entity.LoginAttempt
+	prunedAt time.Time
+}
+
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{
+		attempts: map[string]entity.LoginAttempt{},
+	}
+}
+
+func (s *MemoryLoginAttemptStore) Get(ctx context.Context, key string) (entity.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempt, ok := s.attempts[key]
+	if !ok {
+		return entity.LoginAttempt{}, sql.ErrNoRows
+	}
+	return attempt, nil
+}
+
+func (s *MemoryLoginAttemptStore) RecordFailure(ctx context.Context, key string, window time.Duration) (entity.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.prune(now, window)
+
+	attempt, ok := s.attempts[key]
+	if !ok || now.Sub(attempt.LastFailedAt) > window {
+		attempt.Key = key
+		attempt.Failures = 0
+	}
+	attempt.Failures++
+	attempt.LastFailedAt = now
+	s.attempts[key] = attempt
+
+	return attempt, nil
+}
+
+func (s *MemoryLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempt, ok := s.attempts[key]
+	if !ok {
+		return nil
+	}
+	attempt.Failures = 0
+	attempt.LockedUntil = &until
+	s.attempts[key] = attempt
+
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) Forgive(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempt, ok := s.attempts[key]
+	if !ok || attempt.Failures == 0 {
+		return nil
+	}
+	attempt.Failures--
+	s.attempts[key] = attempt
+
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+	return nil
+}
+
+// prune drops the keys whose failures are older than window and that are
+// not locked, at most once per window, so guessing at many accounts does not
+// grow the map forever.
+func (s *MemoryLoginAttemptStore) prune(now time.Time, window time.Duration) {
+	if now.Sub(s.prunedAt) < window {
+		return
+	}
+	s.prunedAt = now
+
+	for key, attempt := range s.attempts {
+		if now.Sub(attempt.LastFailedAt) > window && (attempt.LockedUntil == nil || !attempt.LockedUntil.After(now)) {
+			delete(s.attempts, key)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	i18n_err "go-test/lib/i18n/errors"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+)
+
+func TestAllowMailCooldown(t *testing.T) {
+	ctx := context.Background()
+	throttle := NewLoginThrottle(NewMemoryLoginAttemptStore(), LoginThrottleConfig{
+		FailureWindow: 15 * time.Minute,
+		MailCooldown:  time.Minute,
+	})
+
+	if err := throttle.AllowMail(ctx, "someone@ayo.id"); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	err := throttle.AllowMail(ctx, " Someone@Ayo.id ")
+	if !errors.Is(err, apperrors.ErrTooManyMailRequests) {
+		t.Fatalf("second request: got %v, want ErrTooManyMailRequests", err)
+	}
+	details, ok := i18n_err.Details(err).(contract.LoginRetryDetails)
+	if !ok || details.RetryAfter < 1 || details.RetryAfter > 60 {
+		t.Errorf("details = %#v, want retry_after within the cooldown", i18n_err.Details(err))
+	}
+
+	if err := throttle.AllowMail(ctx, "other@ayo.id"); err != nil {
+		t.Errorf("other email: %v", err)
+	}
+}
+
+func TestLoginThrottle(t *testing.T) {
+	type attempt struct {
+		email string
+		ip    string
+		// ok is whether the password is right once the attempt is allowed.
+		ok      bool
+		wantErr error
+	}
+	fail := func(email, ip string) attempt { return attempt{email: email, ip: ip} }
+	succeed := func(email, ip string) attempt { return attempt{email: email, ip: ip, ok: true} }
+	rejected := func(a attempt, err error) attempt { a.wantErr = err; return a }
+
+	tests := []struct {
+		name     string
+		attempts []attempt
+	}{
+		{
+			name: "account is locked after max failures, even with the right password",
+			attempts: []attempt{
+				fail("a@ayo.id", "10.0.0.1"),
+				fail("a@ayo.id", "10.0.0.2"),
+				fail("a@ayo.id", "10.0.0.3"),
+				rejected(succeed("a@ayo.id", "10.0.0.4"), apperrors.ErrAccountLocked),
+				succeed("b@ayo.id", "10.0.0.4"),
+			},
+		},
+		{
+			name: "ip is locked after failures at any account",
+			attempts: []attempt{
+				fail("a@ayo.id", "10.0.0.1"),
+				fail("b@ayo.id", "10.0.0.1"),
+				fail("c@ayo.id", "10.0.0.1"),
+				fail("d@ayo.id", "10.0.0.1"),
+				fail("e@ayo.id", "10.0.0.1"),
+				rejected(succeed("f@ayo.id", "10.0.0.1"), apperrors.ErrTooManyLoginAttempts),
+				succeed("f@ayo.id", "10.0.0.2"),
+			},
+		},
+		{
+			name: "successful login resets the account failures",
+			attempts: []attempt{
+				fail("a@ayo.id", "10.0.0.1"),
+				fail("a@ayo.id", "10.0.0.1"),
+				succeed("a@ayo.id", "10.0.0.1"),
+				fail("a@ayo.id", "10.0.0.1"),
+				fail("a@ayo.id", "10.0.0.1"),
+				succeed("a@ayo.id", "10.0.0.1"),
+			},
+		},
+		{
+			name: "successful logins do not count towards the ip limit",
+			attempts: []attempt{
+				succeed("a@ayo.id", "10.0.0.1"),
+				succeed("b@ayo.id", "10.0.0.1"),
+				succeed("c@ayo.id", "10.0.0.1"),
+				succeed("d@ayo.id", "10.0.0.1"),
+				succeed("e@ayo.id", "10.0.0.1"),
+				succeed("f@ayo.id", "10.0.0.1"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			throttle := NewLoginThrottle(NewMemoryLoginAttemptStore(), LoginThrottleConfig{
+				MaxFailures:   3,
+				IPMaxFailures: 5,
+				FailureWindow: 15 * time.Minute,
+				Lockout:       time.Hour,
+			})
+
+			for i, a := range tt.attempts {
+				err := throttle.Allow(ctx, a.email, a.ip)
+				if a.wantErr != nil {
+					if !errors.Is(err, a.wantErr) {
+						t.Fatalf("attempt %d: got %v, want %v", i, err, a.wantErr)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("attempt %d: %v", i, err)
+				}
+				if a.ok {
+					throttle.RecordSuccess(ctx, a.email, a.ip)
+				} else {
+					throttle.RecordFailure(ctx, a.email, a.ip)
+				}
+			}
+		})
+	}
+}
+
+func TestLoginThrottleDelay(t *testing.T) {
+	throttle := NewLoginThrottle(NewMemoryLoginAttemptStore(), LoginThrottleConfig{
+		Delay:   time.Second,
+		Lockout: time.Minute,
+	})
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{failures: 1, want: time.Second},
+		{failures: 2, want: 2 * time.Second},
+		{failures: 4, want: 8 * time.Second},
+		{failures: 6, want: 32 * time.Second},
+		{failures: 7, want: time.Minute},
+		{failures: 100, want: time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := throttle.delay(tt.failures); got != tt.want {
+			t.Errorf("delay(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestLoginThrottleWaitsAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	throttle := NewLoginThrottle(NewMemoryLoginAttemptStore(), LoginThrottleConfig{
+		MaxFailures:   5,
+		IPMaxFailures: 20,
+		FailureWindow: 15 * time.Minute,
+		Lockout:       time.Hour,
+		Delay:         time.Minute,
+	})
+
+	if err := throttle.Allow(ctx, "a@ayo.id", "10.0.0.1"); err != nil {
+		t.Fatalf("first attempt: %v", err)
+	}
+	throttle.RecordFailure(ctx, "a@ayo.id", "10.0.0.1")
+
+	err := throttle.Allow(ctx, "a@ayo.id", "10.0.0.2")
+	if !errors.Is(err, apperrors.ErrTooManyLoginAttempts) {
+		t.Fatalf("second attempt: got %v, want ErrTooManyLoginAttempts", err)
+	}
+	details, ok := i18n_err.Details(err).(contract.LoginRetryDetails)
+	if !ok || details.RetryAfter < 59 || details.RetryAfter > 60 {
+		t.Errorf("details = %#v, want retry_after of the first delay", i18n_err.Details(err))
+	}
+}
+
+func TestLoginThrottleParallelAttempts(t *testing.T) {
+	ctx := context.Background()
+	throttle := NewLoginThrottle(NewMemoryLoginAttemptStore(), LoginThrottleConfig{
+		MaxFailures:   3,
+		IPMaxFailures: 100,
+		FailureWindow: 15 * time.Minute,
+		Lockout:       time.Hour,
+	})
+
+	// None of the guesses fails before all of them asked, so only the count
+	// taken when an attempt is allowed can stop them.
+	var allowed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if throttle.Allow(ctx, "a@ayo.id", "10.0.0.1") == nil {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := allowed.Load(); got < 1 || got > 3 {
+		t.Errorf("allowed %d parallel attempts, want 1 to 3", got)
+	}
+}
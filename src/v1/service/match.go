@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"go-test/lib/atomic"
+	i18n_err "go-test/lib/i18n/errors"
 	"go-test/lib/logger"
 	"go-test/src/entity"
 	apperrors "go-test/src/errors"
 	"go-test/src/v1/contract"
+	"math"
+	"sync"
 	"time"
 )
 
@@ -17,12 +20,123 @@ func parseDate(s string) time.Time {
 	return t
 }
 
+var locationCache sync.Map
+
+// loadLocation returns the IANA location with the given name, falling back
+// to UTC for names that cannot be loaded.
+func loadLocation(name string) *time.Location {
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	locationCache.Store(name, loc)
+	return loc
+}
+
+// setKickoff sets the kickoff of m from an RFC3339 kickoffAt or, failing
+// that, from a local date and HH:MM in the match timezone. Empty date, time
+// or timezone keep the current value of m. The legacy MatchDate and
+// MatchTime are then derived from the kickoff in the match timezone.
+func setKickoff(m *entity.Match, kickoffAt, matchDate, matchTime, timezone string) error {
+	if timezone == "" {
+		timezone = m.Timezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		return apperrors.ErrInvalidTimezone
+	}
+
+	var kickoff time.Time
+	if kickoffAt != "" {
+		kickoff, err = time.Parse(time.RFC3339, kickoffAt)
+	} else {
+		if matchDate == "" {
+			matchDate = m.MatchDate.Format("2006-01-02")
+		}
+		if matchTime == "" {
+			matchTime = m.MatchTime
+		}
+		kickoff, err = time.ParseInLocation("2006-01-02 15:04", matchDate+" "+matchTime, loc)
+	}
+	if err != nil {
+		return apperrors.ErrValidationFailed
+	}
+
+	local := kickoff.In(loc)
+	m.Timezone = timezone
+	m.KickoffAt = kickoff
+	m.MatchDate = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	m.MatchTime = local.Format("15:04")
+	return nil
+}
+
+// asOfCutoff turns an as_of date into the exclusive cutoff instant at the
+// start of the following day in the server-local timezone (DEFAULT_TIMEZONE).
+// An empty date yields nil, meaning "now".
+func asOfCutoff(asOf string) *time.Time {
+	if asOf == "" {
+		return nil
+	}
+	day := parseDate(asOf)
+	cutoff := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, time.Local)
+	return &cutoff
+}
+
+func parseDateRange(from, to string) (*time.Time, *time.Time, error) {
+	var fromDate, toDate *time.Time
+	if from != "" {
+		t := parseDate(from)
+		fromDate = &t
+	}
+	if to != "" {
+		t := parseDate(to)
+		toDate = &t
+	}
+	if fromDate != nil && toDate != nil && fromDate.After(*toDate) {
+		return nil, nil, apperrors.ErrInvalidDateRange
+	}
+	return fromDate, toDate, nil
+}
+
+func formatDatePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
+
+type ScheduleConfig struct {
+	MinRestDays     int
+	DefaultTimezone string
+}
+
+const (
+	ScheduleConflictSameDay          = "same_day"
+	ScheduleConflictInsufficientRest = "insufficient_rest"
+	ScheduleConflictVenueBooked      = "venue_booked"
+
+	// venueSuggestionDays is how far around a blocked date alternative dates
+	// are searched for, in days each way.
+	venueSuggestionDays  = 14
+	venueSuggestionCount = 3
+)
+
 type MatchService struct {
-	matchRepo     MatchRepository
-	teamRepo      TeamRepository
-	playerRepo    PlayerRepository
-	goalRepo      GoalRepository
-	atomicSession atomic.AtomicSessionProvider
+	matchRepo      MatchRepository
+	teamRepo       TeamRepository
+	playerRepo     PlayerRepository
+	goalRepo       GoalRepository
+	cardRepo       CardRepository
+	staffRepo      StaffRepository
+	venueRepo      VenueRepository
+	venueBlockRepo VenueBlockRepository
+	ratingService  *RatingService
+	atomicSession  atomic.AtomicSessionProvider
+	scheduleCfg    ScheduleConfig
 }
 
 func NewMatchService(
@@ -30,14 +144,26 @@ func NewMatchService(
 	teamRepo TeamRepository,
 	playerRepo PlayerRepository,
 	goalRepo GoalRepository,
+	cardRepo CardRepository,
+	staffRepo StaffRepository,
+	venueRepo VenueRepository,
+	venueBlockRepo VenueBlockRepository,
+	ratingService *RatingService,
 	atomicSession atomic.AtomicSessionProvider,
+	scheduleCfg ScheduleConfig,
 ) *MatchService {
 	return &MatchService{
-		matchRepo:     matchRepo,
-		teamRepo:      teamRepo,
-		playerRepo:    playerRepo,
-		goalRepo:      goalRepo,
-		atomicSession: atomicSession,
+		matchRepo:      matchRepo,
+		teamRepo:       teamRepo,
+		playerRepo:     playerRepo,
+		goalRepo:       goalRepo,
+		cardRepo:       cardRepo,
+		staffRepo:      staffRepo,
+		venueRepo:      venueRepo,
+		venueBlockRepo: venueBlockRepo,
+		ratingService:  ratingService,
+		atomicSession:  atomicSession,
+		scheduleCfg:    scheduleCfg,
 	}
 }
 
@@ -62,13 +188,38 @@ func (s *MatchService) CreateMatch(ctx context.Context, req contract.CreateMatch
 		return nil, err
 	}
 
+	venueID := homeTeam.HomeVenueID
+	if req.VenueID > 0 {
+		venueID = &req.VenueID
+	}
+	venue, err := s.getVenue(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+
 	match := &entity.Match{
 		HomeTeamID: req.HomeTeamID,
 		AwayTeamID: req.AwayTeamID,
-		MatchDate:  parseDate(req.MatchDate),
-		MatchTime:  req.MatchTime,
+		Timezone:   s.scheduleCfg.DefaultTimezone,
+		VenueID:    venueID,
 		Status:     entity.MatchStatusScheduled,
 	}
+	if venue != nil {
+		match.Timezone = venue.Timezone
+	}
+	if err := setKickoff(match, req.KickoffAt, req.MatchDate, req.MatchTime, req.Timezone); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkVenueAvailability(ctx, match); err != nil {
+		return nil, err
+	}
+
+	if !req.Force {
+		if err := s.checkScheduleConflicts(ctx, match); err != nil {
+			return nil, err
+		}
+	}
 
 	var matchID int64
 	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
@@ -86,7 +237,7 @@ func (s *MatchService) CreateMatch(ctx context.Context, req contract.CreateMatch
 	}
 
 	match.ID = matchID
-	return matchToResponse(match, homeTeam, awayTeam, nil), nil
+	return matchToResponse(match, homeTeam, awayTeam, venue, nil), nil
 }
 
 func (s *MatchService) GetMatch(ctx context.Context, id int64) (*contract.MatchResponse, error) {
@@ -117,7 +268,19 @@ func (s *MatchService) GetMatch(ctx context.Context, id int64) (*contract.MatchR
 		return nil, err
 	}
 
-	return matchToResponse(&match, homeTeam, awayTeam, goalDetails), nil
+	cardDetails, err := s.buildCardDetails(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	venue, err := s.matchVenue(ctx, &match)
+	if err != nil {
+		return nil, err
+	}
+
+	response := matchToResponse(&match, homeTeam, awayTeam, venue, goalDetails)
+	response.Cards = cardDetails
+	return response, nil
 }
 
 func (s *MatchService) GetAllMatches(ctx context.Context) ([]contract.MatchResponse, error) {
@@ -136,7 +299,11 @@ func (s *MatchService) GetAllMatches(ctx context.Context) ([]contract.MatchRespo
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, *matchToResponse(&m, homeTeam, awayTeam, nil))
+		venue, err := s.matchVenue(ctx, &m)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *matchToResponse(&m, homeTeam, awayTeam, venue, nil))
 	}
 
 	return result, nil
@@ -158,14 +325,17 @@ func (s *MatchService) UpdateMatch(ctx context.Context, id int64, req contract.U
 	targetHome := match.HomeTeamID
 	targetAway := match.AwayTeamID
 
+	var newHomeTeam *entity.Team
 	if req.HomeTeamID > 0 {
 		targetHome = req.HomeTeamID
-		if _, err := s.teamRepo.Get(ctx, targetHome); err != nil {
+		team, err := s.teamRepo.Get(ctx, targetHome)
+		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return nil, apperrors.ErrTeamNotFound
 			}
 			return nil, err
 		}
+		newHomeTeam = &team
 	}
 	if req.AwayTeamID > 0 {
 		targetAway = req.AwayTeamID
@@ -181,17 +351,41 @@ func (s *MatchService) UpdateMatch(ctx context.Context, id int64, req contract.U
 		return nil, apperrors.ErrSameTeamMatch
 	}
 
+	if req.VenueID > 0 {
+		if _, err := s.getVenue(ctx, &req.VenueID); err != nil {
+			return nil, err
+		}
+		match.VenueID = &req.VenueID
+	} else if newHomeTeam != nil && newHomeTeam.ID != match.HomeTeamID {
+		oldHomeTeam, err := s.teamRepo.Get(ctx, match.HomeTeamID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		if sameVenue(match.VenueID, oldHomeTeam.HomeVenueID) {
+			match.VenueID = newHomeTeam.HomeVenueID
+		}
+	}
+
 	if req.HomeTeamID > 0 {
 		match.HomeTeamID = req.HomeTeamID
 	}
 	if req.AwayTeamID > 0 {
 		match.AwayTeamID = req.AwayTeamID
 	}
-	if req.MatchDate != "" {
-		match.MatchDate = parseDate(req.MatchDate)
+	if req.KickoffAt != "" || req.MatchDate != "" || req.MatchTime != "" || req.Timezone != "" {
+		if err := setKickoff(&match, req.KickoffAt, req.MatchDate, req.MatchTime, req.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.checkVenueAvailability(ctx, &match); err != nil {
+		return nil, err
 	}
-	if req.MatchTime != "" {
-		match.MatchTime = req.MatchTime
+
+	if !req.Force {
+		if err := s.checkScheduleConflicts(ctx, &match); err != nil {
+			return nil, err
+		}
 	}
 
 	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
@@ -205,12 +399,15 @@ func (s *MatchService) UpdateMatch(ctx context.Context, id int64, req contract.U
 
 	homeTeam, _ := s.teamRepo.Get(ctx, match.HomeTeamID)
 	awayTeam, _ := s.teamRepo.Get(ctx, match.AwayTeamID)
+	venue, _ := s.matchVenue(ctx, &match)
 
-	return matchToResponse(&match, homeTeam, awayTeam, nil), nil
+	return matchToResponse(&match, homeTeam, awayTeam, venue, nil), nil
 }
 
+// DeleteMatch deletes the match with its goals and cards. The ratings of a completed
+// match are discarded and the matches played after it are rated again.
 func (s *MatchService) DeleteMatch(ctx context.Context, id int64) error {
-	_, err := s.matchRepo.Get(ctx, id)
+	match, err := s.matchRepo.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return apperrors.ErrMatchNotFound
@@ -222,7 +419,16 @@ func (s *MatchService) DeleteMatch(ctx context.Context, id int64) error {
 		if err := s.goalRepo.DeleteByMatch(ctx, id); err != nil {
 			return err
 		}
-		return s.matchRepo.Delete(ctx, id)
+		if err := s.cardRepo.DeleteByMatch(ctx, id); err != nil {
+			return err
+		}
+		if err := s.matchRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if match.Status != entity.MatchStatusCompleted {
+			return nil
+		}
+		return s.ratingService.RerateFrom(ctx, match.MatchDate)
 	})
 }
 
@@ -244,26 +450,61 @@ func (s *MatchService) SubmitResult(ctx context.Context, matchID int64, req cont
 	match.HomeScore = &homeScore
 	match.AwayScore = &awayScore
 
+	goals := make([]entity.Goal, 0, len(req.Goals))
+	for _, g := range req.Goals {
+		player, err := s.matchPlayer(ctx, &match, g.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, entity.Goal{
+			MatchID:    matchID,
+			PlayerID:   g.PlayerID,
+			TeamID:     &player.TeamID,
+			GoalMinute: g.GoalMinute,
+		})
+	}
+
+	cards := make([]entity.Card, 0, len(req.Cards))
+	for _, c := range req.Cards {
+		player, err := s.matchPlayer(ctx, &match, c.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, entity.Card{
+			MatchID:    matchID,
+			PlayerID:   c.PlayerID,
+			TeamID:     player.TeamID,
+			CardType:   entity.CardType(c.CardType),
+			CardMinute: c.CardMinute,
+		})
+	}
+
 	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
 		if err := s.goalRepo.DeleteByMatch(ctx, matchID); err != nil {
 			return err
 		}
 
+		if err := s.cardRepo.DeleteByMatch(ctx, matchID); err != nil {
+			return err
+		}
+
 		if err := s.matchRepo.SetResult(ctx, &match); err != nil {
 			return err
 		}
 
-		for _, g := range req.Goals {
-			goal := &entity.Goal{
-				MatchID:    matchID,
-				PlayerID:   g.PlayerID,
-				GoalMinute: g.GoalMinute,
+		for i := range goals {
+			if _, err := s.goalRepo.Create(ctx, &goals[i]); err != nil {
+				return err
 			}
-			if _, err := s.goalRepo.Create(ctx, goal); err != nil {
+		}
+
+		for i := range cards {
+			if _, err := s.cardRepo.Create(ctx, &cards[i]); err != nil {
 				return err
 			}
 		}
-		return nil
+
+		return s.ratingService.RateMatch(ctx, &match)
 	})
 
 	if err != nil {
@@ -275,17 +516,24 @@ func (s *MatchService) SubmitResult(ctx context.Context, matchID int64, req cont
 
 	homeTeam, _ := s.teamRepo.Get(ctx, match.HomeTeamID)
 	awayTeam, _ := s.teamRepo.Get(ctx, match.AwayTeamID)
+	venue, _ := s.matchVenue(ctx, &match)
 
-	goals, err := s.goalRepo.GetByMatch(ctx, matchID)
+	savedGoals, err := s.goalRepo.GetByMatch(ctx, matchID)
 	if err != nil {
 		return nil, err
 	}
-	goalDetails, err := s.buildGoalDetails(ctx, goals)
+	goalDetails, err := s.buildGoalDetails(ctx, savedGoals)
+	if err != nil {
+		return nil, err
+	}
+	cardDetails, err := s.buildCardDetails(ctx, matchID)
 	if err != nil {
 		return nil, err
 	}
 
-	return matchToResponse(&match, homeTeam, awayTeam, goalDetails), nil
+	response := matchToResponse(&match, homeTeam, awayTeam, venue, goalDetails)
+	response.Cards = cardDetails
+	return response, nil
 }
 
 func (s *MatchService) GetMatchReport(ctx context.Context, matchID int64) (*contract.MatchReportResponse, error) {
@@ -341,10 +589,37 @@ func (s *MatchService) GetMatchReport(ctx context.Context, matchID int64) (*cont
 		return nil, err
 	}
 
+	h2h, err := s.matchRepo.GetHeadToHeadSummary(ctx, match.HomeTeamID, match.AwayTeamID, &match.MatchDate, nil, match.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	venue, err := s.matchVenue(ctx, &match)
+	if err != nil {
+		return nil, err
+	}
+
+	cardDetails, err := s.buildCardDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	homeCoaches, err := s.coachesOnDate(ctx, match.HomeTeamID, match.MatchDate)
+	if err != nil {
+		return nil, err
+	}
+	awayCoaches, err := s.coachesOnDate(ctx, match.AwayTeamID, match.MatchDate)
+	if err != nil {
+		return nil, err
+	}
+
 	return &contract.MatchReportResponse{
-		MatchID:   match.ID,
-		MatchDate: matchDateStr,
-		MatchTime: match.MatchTime,
+		MatchID:         match.ID,
+		KickoffAt:       match.KickoffAt.In(loadLocation(match.Timezone)),
+		Timezone:        match.Timezone,
+		DisplayTimezone: match.Timezone,
+		MatchDate:       matchDateStr,
+		MatchTime:       match.MatchTime,
 		HomeTeam: contract.TeamBrief{
 			ID:   homeTeam.ID,
 			Name: homeTeam.Name,
@@ -355,6 +630,11 @@ func (s *MatchService) GetMatchReport(ctx context.Context, matchID int64) (*cont
 			Name: awayTeam.Name,
 			Logo: awayTeam.Logo,
 		},
+		Venue: venueToBrief(venue),
+		Coaches: contract.MatchCoaches{
+			HomeTeam: homeCoaches,
+			AwayTeam: awayCoaches,
+		},
 		HomeScore:         homeScore,
 		AwayScore:         awayScore,
 		FinalStatus:       finalStatus,
@@ -362,28 +642,39 @@ func (s *MatchService) GetMatchReport(ctx context.Context, matchID int64) (*cont
 		HomeTeamTotalWins: homeWins,
 		AwayTeamTotalWins: awayWins,
 		Goals:             goalDetails,
+		Cards:             cardDetails,
+		HeadToHead: contract.MatchReportHeadToHead{
+			Played:       h2h.Played,
+			HomeTeamWins: h2h.TeamWins,
+			AwayTeamWins: h2h.OpponentWins,
+			Draws:        h2h.Draws,
+		},
 	}, nil
 }
 
+// matchPlayer returns a player named in the result of match, who has to play
+// for the home or away team. Goals and cards are recorded for that team.
+func (s *MatchService) matchPlayer(ctx context.Context, match *entity.Match, playerID int64) (entity.Player, error) {
+	player, err := s.playerRepo.Get(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Player{}, apperrors.ErrPlayerNotFound
+		}
+		return entity.Player{}, err
+	}
+	if player.TeamID != match.HomeTeamID && player.TeamID != match.AwayTeamID {
+		return entity.Player{}, apperrors.ErrPlayerNotInMatch
+	}
+	return player, nil
+}
+
 func (s *MatchService) countWins(ctx context.Context, teamID int64, untilDate string) (int, error) {
-	stats, err := s.matchRepo.GetCompletedByTeam(ctx, teamID, untilDate)
+	until := parseDate(untilDate)
+	stat, err := s.matchRepo.GetTeamStats(ctx, teamID, nil, &until, nil)
 	if err != nil {
 		return 0, err
 	}
-	wins := 0
-	for _, m := range stats {
-		if m.HomeScore == nil || m.AwayScore == nil {
-			continue
-		}
-		home := *m.HomeScore
-		away := *m.AwayScore
-		if m.HomeTeamID == teamID && home > away {
-			wins++
-		} else if m.AwayTeamID == teamID && away > home {
-			wins++
-		}
-	}
-	return wins, nil
+	return stat.HomeWins + stat.AwayWins, nil
 }
 
 func (s *MatchService) buildGoalDetails(ctx context.Context, goals []entity.Goal) ([]contract.GoalDetail, error) {
@@ -404,6 +695,251 @@ func (s *MatchService) buildGoalDetails(ctx context.Context, goals []entity.Goal
 	return details, nil
 }
 
+// coachesOnDate returns the coaching staff of a team in post on date.
+func (s *MatchService) coachesOnDate(ctx context.Context, teamID int64, date time.Time) ([]contract.StaffBrief, error) {
+	staff, err := s.staffRepo.GetByTeamOnDate(ctx, teamID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	coaches := make([]contract.StaffBrief, 0, len(staff))
+	for _, m := range staff {
+		if !m.Role.IsCoach() {
+			continue
+		}
+		coaches = append(coaches, contract.StaffBrief{
+			ID:   m.ID,
+			Name: m.Name,
+			Role: string(m.Role),
+		})
+	}
+	return coaches, nil
+}
+
+func (s *MatchService) buildCardDetails(ctx context.Context, matchID int64) ([]contract.CardDetail, error) {
+	cards, err := s.cardRepo.GetByMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]contract.CardDetail, 0, len(cards))
+	for _, c := range cards {
+		player, err := s.playerRepo.Get(ctx, c.PlayerID)
+		playerName := ""
+		if err == nil {
+			playerName = player.Name
+		}
+		details = append(details, contract.CardDetail{
+			ID:         c.ID,
+			PlayerID:   c.PlayerID,
+			PlayerName: playerName,
+			TeamID:     c.TeamID,
+			CardType:   string(c.CardType),
+			CardMinute: c.CardMinute,
+		})
+	}
+	return details, nil
+}
+
+// checkScheduleConflicts reports every other fixture of either team that is
+// on the same day or leaves fewer than MinRestDays full days of rest.
+func (s *MatchService) checkScheduleConflicts(ctx context.Context, match *entity.Match) error {
+	window := s.scheduleCfg.MinRestDays
+	fixtures, err := s.matchRepo.GetTeamFixturesInRange(
+		ctx,
+		match.HomeTeamID,
+		match.AwayTeamID,
+		match.MatchDate.AddDate(0, 0, -window),
+		match.MatchDate.AddDate(0, 0, window),
+		match.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	conflicts := make([]contract.ScheduleConflict, 0)
+	for _, f := range fixtures {
+		days := daysBetween(match.MatchDate, f.MatchDate)
+		conflictType := ScheduleConflictInsufficientRest
+		if days == 0 {
+			conflictType = ScheduleConflictSameDay
+		}
+
+		for _, teamID := range []int64{match.HomeTeamID, match.AwayTeamID} {
+			if f.HomeTeamID != teamID && f.AwayTeamID != teamID {
+				continue
+			}
+			conflicts = append(conflicts, contract.ScheduleConflict{
+				Type:      conflictType,
+				TeamID:    teamID,
+				MatchID:   f.ID,
+				MatchDate: f.MatchDate.Format("2006-01-02"),
+				RestDays:  max(days-1, 0),
+			})
+		}
+	}
+
+	if match.VenueID != nil {
+		booked, err := s.matchRepo.GetVenueFixturesInRange(ctx, *match.VenueID, match.MatchDate, match.MatchDate, match.ID)
+		if err != nil {
+			return err
+		}
+		for _, f := range booked {
+			conflicts = append(conflicts, contract.ScheduleConflict{
+				Type:      ScheduleConflictVenueBooked,
+				VenueID:   *match.VenueID,
+				MatchID:   f.ID,
+				MatchDate: f.MatchDate.Format("2006-01-02"),
+			})
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return i18n_err.WithDetails(apperrors.ErrScheduleConflict, conflicts)
+	}
+	return nil
+}
+
+// checkVenueAvailability refuses a match on a date its venue is blocked and
+// suggests the nearest dates on which the venue is free and neither team has
+// a scheduling conflict. Blocks cannot be overridden with force.
+func (s *MatchService) checkVenueAvailability(ctx context.Context, match *entity.Match) error {
+	if match.VenueID == nil {
+		return nil
+	}
+
+	blocks, err := s.venueBlockRepo.GetByVenueInRange(ctx, *match.VenueID, match.MatchDate, match.MatchDate)
+	if err != nil {
+		return err
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	suggestions, err := s.suggestVenueDates(ctx, match)
+	if err != nil {
+		return err
+	}
+
+	details := contract.VenueUnavailableDetails{
+		VenueID:        *match.VenueID,
+		Blocks:         make([]contract.VenueBlockResponse, 0, len(blocks)),
+		SuggestedDates: suggestions,
+	}
+	for i := range blocks {
+		details.Blocks = append(details.Blocks, *venueBlockToResponse(&blocks[i]))
+	}
+
+	return i18n_err.WithDetails(apperrors.ErrVenueUnavailable, details)
+}
+
+// suggestVenueDates returns up to venueSuggestionCount dates within
+// venueSuggestionDays of the match date, nearest first, that are not in the
+// past, not blocked, not booked at the venue and leave both teams enough rest.
+func (s *MatchService) suggestVenueDates(ctx context.Context, match *entity.Match) ([]string, error) {
+	from := match.MatchDate.AddDate(0, 0, -venueSuggestionDays)
+	to := match.MatchDate.AddDate(0, 0, venueSuggestionDays)
+	rest := s.scheduleCfg.MinRestDays
+
+	blocks, err := s.venueBlockRepo.GetByVenueInRange(ctx, *match.VenueID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	booked, err := s.matchRepo.GetVenueFixturesInRange(ctx, *match.VenueID, from, to, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	fixtures, err := s.matchRepo.GetTeamFixturesInRange(
+		ctx,
+		match.HomeTeamID,
+		match.AwayTeamID,
+		from.AddDate(0, 0, -rest),
+		to.AddDate(0, 0, rest),
+		match.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isFree := func(date time.Time) bool {
+		for _, b := range blocks {
+			if !date.Before(b.StartDate) && !date.After(b.EndDate) {
+				return false
+			}
+		}
+		for _, m := range booked {
+			if m.MatchDate.Equal(date) {
+				return false
+			}
+		}
+		for _, f := range fixtures {
+			if daysBetween(date, f.MatchDate) <= rest {
+				return false
+			}
+		}
+		return true
+	}
+
+	now := time.Now().In(loadLocation(match.Timezone))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	suggestions := make([]string, 0, venueSuggestionCount)
+	for offset := 1; offset <= venueSuggestionDays; offset++ {
+		for _, d := range []int{offset, -offset} {
+			date := match.MatchDate.AddDate(0, 0, d)
+			if date.Before(today) || !isFree(date) {
+				continue
+			}
+			suggestions = append(suggestions, date.Format("2006-01-02"))
+			if len(suggestions) == venueSuggestionCount {
+				return suggestions, nil
+			}
+		}
+	}
+	return suggestions, nil
+}
+
+// getVenue returns the venue with the given ID, or nil for a nil ID.
+func (s *MatchService) getVenue(ctx context.Context, venueID *int64) (*entity.Venue, error) {
+	if venueID == nil {
+		return nil, nil
+	}
+	venue, err := s.venueRepo.Get(ctx, *venueID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrVenueNotFound
+		}
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// matchVenue returns the venue of a match, or nil when the match has no venue
+// or its venue has since been deleted.
+func (s *MatchService) matchVenue(ctx context.Context, match *entity.Match) (*entity.Venue, error) {
+	venue, err := s.getVenue(ctx, match.VenueID)
+	if errors.Is(err, apperrors.ErrVenueNotFound) {
+		return nil, nil
+	}
+	return venue, err
+}
+
+func sameVenue(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// daysBetween returns the absolute number of calendar days between two dates.
+func daysBetween(a, b time.Time) int {
+	days := int(math.Round(b.Sub(a).Hours() / 24))
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
 func computeTopScorer(goals []entity.Goal, details []contract.GoalDetail) *contract.TopScorerInfo {
 	if len(goals) == 0 {
 		return nil
@@ -435,7 +971,7 @@ func computeTopScorer(goals []entity.Goal, details []contract.GoalDetail) *contr
 	}
 }
 
-func matchToResponse(m *entity.Match, homeTeam entity.Team, awayTeam entity.Team, goals []contract.GoalDetail) *contract.MatchResponse {
+func matchToResponse(m *entity.Match, homeTeam entity.Team, awayTeam entity.Team, venue *entity.Venue, goals []contract.GoalDetail) *contract.MatchResponse {
 	return &contract.MatchResponse{
 		ID: m.ID,
 		HomeTeam: contract.TeamBrief{
@@ -448,13 +984,17 @@ func matchToResponse(m *entity.Match, homeTeam entity.Team, awayTeam entity.Team
 			Name: awayTeam.Name,
 			Logo: awayTeam.Logo,
 		},
-		MatchDate: m.MatchDate.Format("2006-01-02"),
-		MatchTime: m.MatchTime,
-		HomeScore: m.HomeScore,
-		AwayScore: m.AwayScore,
-		Status:    string(m.Status),
-		Goals:     goals,
-		CreatedAt: m.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt: m.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Venue:           venueToBrief(venue),
+		KickoffAt:       m.KickoffAt.In(loadLocation(m.Timezone)),
+		Timezone:        m.Timezone,
+		DisplayTimezone: m.Timezone,
+		MatchDate:       m.MatchDate.Format("2006-01-02"),
+		MatchTime:       m.MatchTime,
+		HomeScore:       m.HomeScore,
+		AwayScore:       m.AwayScore,
+		Status:          string(m.Status),
+		Goals:           goals,
+		CreatedAt:       m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       m.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-test/lib/atomic"
+	i18n_err "go-test/lib/i18n/errors"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+	"strings"
+	"time"
+)
+
+type OfficialConfig struct {
+	MaxMatchesPerWeek int
+	AllowHomeCity     bool
+}
+
+const (
+	OfficialConflictHomeCity    = "home_city"
+	OfficialConflictWeeklyLimit = "weekly_limit"
+)
+
+// officialRoleLimits is the number of officials of each role a match can have.
+var officialRoleLimits = map[entity.OfficialRole]int{
+	entity.OfficialRoleReferee:          1,
+	entity.OfficialRoleAssistantReferee: 2,
+	entity.OfficialRoleFourthOfficial:   1,
+	entity.OfficialRoleVAR:              1,
+}
+
+type OfficialService struct {
+	officialRepo      OfficialRepository
+	matchOfficialRepo MatchOfficialRepository
+	matchRepo         MatchRepository
+	teamRepo          TeamRepository
+	atomicSession     atomic.AtomicSessionProvider
+	cfg               OfficialConfig
+}
+
+func NewOfficialService(
+	officialRepo OfficialRepository,
+	matchOfficialRepo MatchOfficialRepository,
+	matchRepo MatchRepository,
+	teamRepo TeamRepository,
+	atomicSession atomic.AtomicSessionProvider,
+	cfg OfficialConfig,
+) *OfficialService {
+	return &OfficialService{
+		officialRepo:      officialRepo,
+		matchOfficialRepo: matchOfficialRepo,
+		matchRepo:         matchRepo,
+		teamRepo:          teamRepo,
+		atomicSession:     atomicSession,
+		cfg:               cfg,
+	}
+}
+
+func (s *OfficialService) CreateOfficial(ctx context.Context, req contract.CreateOfficialRequest) (*contract.OfficialResponse, error) {
+	official := &entity.Official{
+		Name:     req.Name,
+		Email:    req.Email,
+		HomeCity: req.HomeCity,
+	}
+
+	var officialID int64
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		id, err := s.officialRepo.Create(ctx, official)
+		if err != nil {
+			return err
+		}
+		officialID = id
+		return nil
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("CreateOfficial err: ", err)
+		return nil, err
+	}
+
+	official.ID = officialID
+	return officialToResponse(official), nil
+}
+
+func (s *OfficialService) GetOfficial(ctx context.Context, id int64) (*contract.OfficialResponse, error) {
+	official, err := s.getOfficial(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return officialToResponse(&official), nil
+}
+
+func (s *OfficialService) GetAllOfficials(ctx context.Context) ([]contract.OfficialResponse, error) {
+	officials, err := s.officialRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.OfficialResponse, 0, len(officials))
+	for _, o := range officials {
+		response = append(response, *officialToResponse(&o))
+	}
+
+	return response, nil
+}
+
+func (s *OfficialService) UpdateOfficial(ctx context.Context, id int64, req contract.UpdateOfficialRequest) (*contract.OfficialResponse, error) {
+	official, err := s.getOfficial(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" {
+		official.Name = req.Name
+	}
+	if req.Email != "" {
+		official.Email = req.Email
+	}
+	if req.HomeCity != "" {
+		official.HomeCity = req.HomeCity
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.officialRepo.Update(ctx, &official)
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("UpdateOfficial err: ", err)
+		return nil, err
+	}
+
+	return officialToResponse(&official), nil
+}
+
+func (s *OfficialService) DeleteOfficial(ctx context.Context, id int64) error {
+	if _, err := s.getOfficial(ctx, id); err != nil {
+		return err
+	}
+
+	return atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.officialRepo.Delete(ctx, id)
+	})
+}
+
+// AssignMatchOfficials replaces the officials of a match. Unless forced, an
+// official may not work a match of a team from their home city or more
+// matches in the ISO week than the configured limit.
+func (s *OfficialService) AssignMatchOfficials(ctx context.Context, matchID int64, req contract.AssignMatchOfficialsRequest) (*contract.MatchOfficialsResponse, error) {
+	match, err := s.matchRepo.Get(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrMatchNotFound
+		}
+		return nil, err
+	}
+
+	roleCounts := make(map[entity.OfficialRole]int)
+	seen := make(map[int64]bool)
+	officials := make([]entity.Official, 0, len(req.Officials))
+	for _, o := range req.Officials {
+		role := entity.OfficialRole(o.Role)
+		roleCounts[role]++
+		if roleCounts[role] > officialRoleLimits[role] || seen[o.OfficialID] {
+			return nil, apperrors.ErrValidationFailed
+		}
+		seen[o.OfficialID] = true
+
+		official, err := s.getOfficial(ctx, o.OfficialID)
+		if err != nil {
+			return nil, err
+		}
+		officials = append(officials, official)
+	}
+
+	if !req.Force {
+		if err := s.checkAssignmentRules(ctx, &match, officials); err != nil {
+			return nil, err
+		}
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.matchOfficialRepo.DeleteByMatch(ctx, matchID); err != nil {
+			return err
+		}
+
+		for _, o := range req.Officials {
+			_, err := s.matchOfficialRepo.Create(ctx, &entity.MatchOfficial{
+				MatchID:    matchID,
+				OfficialID: o.OfficialID,
+				Role:       entity.OfficialRole(o.Role),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("AssignMatchOfficials err: ", err)
+		return nil, err
+	}
+
+	return s.GetMatchOfficials(ctx, matchID)
+}
+
+func (s *OfficialService) GetMatchOfficials(ctx context.Context, matchID int64) (*contract.MatchOfficialsResponse, error) {
+	if _, err := s.matchRepo.Get(ctx, matchID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrMatchNotFound
+		}
+		return nil, err
+	}
+
+	details, err := s.matchOfficialRepo.GetByMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &contract.MatchOfficialsResponse{
+		MatchID:   matchID,
+		Officials: make([]contract.MatchOfficialResponse, 0, len(details)),
+	}
+	for _, d := range details {
+		response.Officials = append(response.Officials, contract.MatchOfficialResponse{
+			ID:       d.ID,
+			Official: contract.OfficialBrief{ID: d.OfficialID, Name: d.OfficialName},
+			Role:     string(d.Role),
+		})
+	}
+
+	return response, nil
+}
+
+// GetOfficialReport lists the matches of an official in a date range with
+// totals per role and the cards shown in the matches they refereed.
+func (s *OfficialService) GetOfficialReport(ctx context.Context, id int64, query contract.OfficialReportQuery) (*contract.OfficialReportResponse, error) {
+	official, err := s.getOfficial(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := parseDateRange(query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.matchOfficialRepo.GetAssignmentsByOfficial(ctx, id, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &contract.OfficialReportResponse{
+		Official:    contract.OfficialBrief{ID: official.ID, Name: official.Name},
+		From:        query.From,
+		To:          query.To,
+		Assignments: make([]contract.OfficialReportAssignment, 0, len(assignments)),
+	}
+	for _, a := range assignments {
+		response.Totals.Matches++
+		switch a.Role {
+		case entity.OfficialRoleReferee:
+			response.Totals.Referee++
+		case entity.OfficialRoleAssistantReferee:
+			response.Totals.AssistantReferee++
+		case entity.OfficialRoleFourthOfficial:
+			response.Totals.FourthOfficial++
+		case entity.OfficialRoleVAR:
+			response.Totals.VAR++
+		}
+		response.Totals.YellowCards += a.YellowCards
+		response.Totals.RedCards += a.RedCards
+
+		response.Assignments = append(response.Assignments, contract.OfficialReportAssignment{
+			MatchID:     a.MatchID,
+			MatchDate:   a.MatchDate.Format("2006-01-02"),
+			KickoffAt:   a.KickoffAt.In(loadLocation(a.Timezone)),
+			Timezone:    a.Timezone,
+			Status:      string(a.Status),
+			HomeTeam:    contract.TeamBrief{ID: a.HomeTeamID, Name: a.HomeTeamName},
+			AwayTeam:    contract.TeamBrief{ID: a.AwayTeamID, Name: a.AwayTeamName},
+			Role:        string(a.Role),
+			YellowCards: a.YellowCards,
+			RedCards:    a.RedCards,
+		})
+	}
+
+	return response, nil
+}
+
+// checkAssignmentRules reports every official who lives in the city of either
+// team or would exceed the weekly match limit in the week of the match.
+func (s *OfficialService) checkAssignmentRules(ctx context.Context, match *entity.Match, officials []entity.Official) error {
+	homeTeam, err := s.teamRepo.Get(ctx, match.HomeTeamID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	awayTeam, err := s.teamRepo.Get(ctx, match.AwayTeamID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	weekStart, weekEnd := isoWeek(match.MatchDate)
+
+	conflicts := make([]contract.OfficialAssignmentConflict, 0)
+	for _, o := range officials {
+		if !s.cfg.AllowHomeCity {
+			for _, team := range []entity.Team{homeTeam, awayTeam} {
+				if sameCity(o.HomeCity, team.City) {
+					conflicts = append(conflicts, contract.OfficialAssignmentConflict{
+						Rule:       OfficialConflictHomeCity,
+						OfficialID: o.ID,
+						TeamID:     team.ID,
+					})
+				}
+			}
+		}
+
+		if s.cfg.MaxMatchesPerWeek > 0 {
+			count, err := s.matchOfficialRepo.CountByOfficialInRange(ctx, o.ID, weekStart, weekEnd, match.ID)
+			if err != nil {
+				return err
+			}
+			if count+1 > s.cfg.MaxMatchesPerWeek {
+				conflicts = append(conflicts, contract.OfficialAssignmentConflict{
+					Rule:          OfficialConflictWeeklyLimit,
+					OfficialID:    o.ID,
+					MatchesInWeek: count + 1,
+					Limit:         s.cfg.MaxMatchesPerWeek,
+				})
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return i18n_err.WithDetails(apperrors.ErrOfficialAssignmentConflict, conflicts)
+	}
+	return nil
+}
+
+func (s *OfficialService) getOfficial(ctx context.Context, id int64) (entity.Official, error) {
+	official, err := s.officialRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Official{}, apperrors.ErrOfficialNotFound
+		}
+		return entity.Official{}, err
+	}
+	return official, nil
+}
+
+// isoWeek returns the Monday and Sunday of the ISO week containing date.
+func isoWeek(date time.Time) (time.Time, time.Time) {
+	offset := (int(date.Weekday()) + 6) % 7
+	start := time.Date(date.Year(), date.Month(), date.Day()-offset, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, 6)
+}
+
+func sameCity(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	return a != "" && strings.EqualFold(a, b)
+}
+
+func officialToResponse(o *entity.Official) *contract.OfficialResponse {
+	return &contract.OfficialResponse{
+		ID:        o.ID,
+		Name:      o.Name,
+		Email:     o.Email,
+		HomeCity:  o.HomeCity,
+		CreatedAt: o.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: o.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -25,7 +25,7 @@ func NewPlayerService(playerRepo PlayerRepository, teamRepo TeamRepository, atom
 	}
 }
 
-func (s *PlayerService) CreatePlayer(ctx context.Context, req contract.CreatePlayerRequest) (*contract.PlayerResponse, error) {
+func (s *PlayerService) CreatePlayer(ctx context.Context, req contract.CreatePlayerRequest, scope *contract.TeamScope) (*contract.PlayerResponse, error) {
 	_, err := s.teamRepo.Get(ctx, req.TeamID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -33,6 +33,9 @@ func (s *PlayerService) CreatePlayer(ctx context.Context, req contract.CreatePla
 		}
 		return nil, err
 	}
+	if !scope.Allows(req.TeamID) {
+		return nil, apperrors.ErrForbidden
+	}
 
 	taken, err := s.playerRepo.IsJerseyTaken(ctx, req.TeamID, req.JerseyNumber, 0)
 	if err != nil {
@@ -119,7 +122,9 @@ func (s *PlayerService) GetPlayersByTeam(ctx context.Context, teamID int64) ([]c
 	return response, nil
 }
 
-func (s *PlayerService) UpdatePlayer(ctx context.Context, id int64, req contract.UpdatePlayerRequest) (*contract.PlayerResponse, error) {
+// UpdatePlayer updates a player. A transfer with team_id needs the caller to
+// manage both the current and the new team.
+func (s *PlayerService) UpdatePlayer(ctx context.Context, id int64, req contract.UpdatePlayerRequest, scope *contract.TeamScope) (*contract.PlayerResponse, error) {
 	player, err := s.playerRepo.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -127,6 +132,9 @@ func (s *PlayerService) UpdatePlayer(ctx context.Context, id int64, req contract
 		}
 		return nil, err
 	}
+	if !scope.Allows(player.TeamID) {
+		return nil, apperrors.ErrForbidden
+	}
 
 	targetTeamID := player.TeamID
 	if req.TeamID > 0 {
@@ -138,6 +146,9 @@ func (s *PlayerService) UpdatePlayer(ctx context.Context, id int64, req contract
 			}
 			return nil, err
 		}
+		if !scope.Allows(targetTeamID) {
+			return nil, apperrors.ErrForbidden
+		}
 	}
 
 	targetJersey := player.JerseyNumber
@@ -186,20 +197,138 @@ func (s *PlayerService) UpdatePlayer(ctx context.Context, id int64, req contract
 	return playerToResponse(&player), nil
 }
 
-func (s *PlayerService) DeletePlayer(ctx context.Context, id int64) error {
-	_, err := s.playerRepo.Get(ctx, id)
+func (s *PlayerService) DeletePlayer(ctx context.Context, id int64, scope *contract.TeamScope) error {
+	player, err := s.playerRepo.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return apperrors.ErrPlayerNotFound
 		}
 		return err
 	}
+	if !scope.Allows(player.TeamID) {
+		return apperrors.ErrForbidden
+	}
 
 	return atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
 		return s.playerRepo.Delete(ctx, id)
 	})
 }
 
+func (s *PlayerService) GetPlayerStats(ctx context.Context, id int64, req contract.PlayerStatsRequest) (*contract.PlayerStatsResponse, error) {
+	player, err := s.playerRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrPlayerNotFound
+		}
+		return nil, err
+	}
+
+	from, to, err := parseDateRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := asOfCutoff(req.AsOf)
+
+	summary, err := s.playerRepo.GetStatSummary(ctx, id, from, to, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	teamStats, err := s.playerRepo.GetStatsByTeam(ctx, id, from, to, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	seasonStats, err := s.playerRepo.GetStatsBySeason(ctx, id, from, to, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]contract.PlayerTeamStats, 0, len(teamStats))
+	for _, t := range teamStats {
+		teams = append(teams, contract.PlayerTeamStats{
+			TeamID:            t.TeamID,
+			TeamName:          t.TeamName,
+			PlayerStatSummary: playerStatSummaryToResponse(t.PlayerStatSummary),
+		})
+	}
+
+	seasons := make([]contract.PlayerSeasonStats, 0, len(seasonStats))
+	for _, st := range seasonStats {
+		seasons = append(seasons, contract.PlayerSeasonStats{
+			Season:            st.Season,
+			PlayerStatSummary: playerStatSummaryToResponse(st.PlayerStatSummary),
+		})
+	}
+
+	return &contract.PlayerStatsResponse{
+		PlayerID: player.ID,
+		Name:     player.Name,
+		TeamID:   player.TeamID,
+		From:     req.From,
+		To:       req.To,
+		AsOf:     req.AsOf,
+		Totals:   playerStatSummaryToResponse(summary),
+		Teams:    teams,
+		Seasons:  seasons,
+	}, nil
+}
+
+func (s *PlayerService) GetLeaderboard(ctx context.Context, req contract.PlayerLeaderboardRequest) (*contract.PlayerLeaderboardResponse, error) {
+	from, to, err := parseDateRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	metric := req.Metric
+	if metric == "" {
+		metric = "goals"
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	entries, err := s.playerRepo.GetLeaderboard(ctx, metric, from, to, limit, asOfCutoff(req.AsOf))
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]contract.PlayerLeaderboardEntry, 0, len(entries))
+	for i, e := range entries {
+		players = append(players, contract.PlayerLeaderboardEntry{
+			Rank:     i + 1,
+			PlayerID: e.PlayerID,
+			Name:     e.PlayerName,
+			Team: contract.TeamBrief{
+				ID:   e.TeamID,
+				Name: e.TeamName,
+				Logo: e.TeamLogo,
+			},
+			MatchesWithGoal: e.MatchesWithGoal,
+			Goals:           e.Goals,
+		})
+	}
+
+	return &contract.PlayerLeaderboardResponse{
+		Metric:  metric,
+		From:    req.From,
+		To:      req.To,
+		AsOf:    req.AsOf,
+		Players: players,
+	}, nil
+}
+
+func playerStatSummaryToResponse(st entity.PlayerStatSummary) contract.PlayerStatSummary {
+	return contract.PlayerStatSummary{
+		MatchesWithGoal: st.MatchesWithGoal,
+		Goals:           st.Goals,
+		FirstGoalDate:   formatDatePtr(st.FirstGoalDate),
+		LastGoalDate:    formatDatePtr(st.LastGoalDate),
+	}
+}
+
 func playerToResponse(p *entity.Player) *contract.PlayerResponse {
 	return &contract.PlayerResponse{
 		ID:           p.ID,
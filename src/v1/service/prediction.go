@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"sort"
+
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+)
+
+const predictionLikelyScores = 5
+
+// GetMatchPrediction returns outcome probabilities for a scheduled match from
+// a Poisson model fitted to all completed results. The probabilities are
+// computed exactly from the score grid, so the same history always yields the
+// same prediction.
+func (s *MatchService) GetMatchPrediction(ctx context.Context, matchID int64) (*contract.MatchPredictionResponse, error) {
+	match, err := s.matchRepo.Get(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrMatchNotFound
+		}
+		return nil, err
+	}
+
+	if match.Status != entity.MatchStatusScheduled {
+		return nil, apperrors.ErrMatchNotScheduled
+	}
+
+	homeTeam, err := s.teamRepo.Get(ctx, match.HomeTeamID)
+	if err != nil {
+		return nil, err
+	}
+	awayTeam, err := s.teamRepo.Get(ctx, match.AwayTeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := s.matchRepo.GetCompleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model := buildStrengthModel(completed)
+	homeLambda, awayLambda := model.expectedGoals(match.HomeTeamID, match.AwayTeamID)
+	grid := scoreGrid(homeLambda, awayLambda)
+
+	var outcome contract.MatchPredictionOutcome
+	scores := make([]contract.MatchPredictionScore, 0, len(grid)*len(grid))
+	for i := range grid {
+		for j, p := range grid[i] {
+			switch {
+			case i > j:
+				outcome.HomeWin += p
+			case i < j:
+				outcome.AwayWin += p
+			default:
+				outcome.Draw += p
+			}
+			scores = append(scores, contract.MatchPredictionScore{HomeScore: i, AwayScore: j, Probability: p})
+		}
+	}
+
+	sort.SliceStable(scores, func(a, b int) bool {
+		return scores[a].Probability > scores[b].Probability
+	})
+	if len(scores) > predictionLikelyScores {
+		scores = scores[:predictionLikelyScores]
+	}
+	for i := range scores {
+		scores[i].Probability = roundProbability(scores[i].Probability)
+	}
+
+	return &contract.MatchPredictionResponse{
+		MatchID:   match.ID,
+		MatchDate: match.MatchDate.Format("2006-01-02"),
+		MatchTime: match.MatchTime,
+		HomeTeam: contract.TeamBrief{
+			ID:   homeTeam.ID,
+			Name: homeTeam.Name,
+			Logo: homeTeam.Logo,
+		},
+		AwayTeam: contract.TeamBrief{
+			ID:   awayTeam.ID,
+			Name: awayTeam.Name,
+			Logo: awayTeam.Logo,
+		},
+		ExpectedGoals: contract.MatchPredictionGoals{
+			Home: roundRating(homeLambda),
+			Away: roundRating(awayLambda),
+		},
+		Probabilities: contract.MatchPredictionOutcome{
+			HomeWin: roundProbability(outcome.HomeWin),
+			Draw:    roundProbability(outcome.Draw),
+			AwayWin: roundProbability(outcome.AwayWin),
+		},
+		LikelyScores:    scores,
+		MatchesAnalysed: model.sampleSize,
+	}, nil
+}
+
+func roundProbability(p float64) float64 {
+	return math.Round(p*10000) / 10000
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+)
+
+func TestGetMatchPrediction(t *testing.T) {
+	// Strengths as in TestBuildStrengthModel: team 2 (attack 0.84) hosts
+	// team 1 (attack 1.32, defence 0.6), so the expected goals are
+	// 1 * 0.84 * 0.6 = 0.504 and 2/3 * 1.32 * 1.32 = 1.1616.
+	history := []entity.Match{
+		completedMatch(1, 1, 2, "2026-01-01", 2, 0),
+		completedMatch(2, 2, 3, "2026-01-08", 1, 1),
+		completedMatch(3, 3, 1, "2026-01-15", 0, 1),
+	}
+	teams := &fakeTeamRepo{teams: []entity.Team{testTeam(1, "Alpha"), testTeam(2, "Bravo"), testTeam(3, "Charlie")}}
+
+	tests := []struct {
+		name    string
+		matches []entity.Match
+		matchID int64
+		want    *contract.MatchPredictionResponse
+		wantErr error
+	}{
+		{
+			name:    "known fixture gives known odds",
+			matches: append([]entity.Match{scheduledMatch(4, 2, 1, "2026-01-22")}, history...),
+			matchID: 4,
+			want: &contract.MatchPredictionResponse{
+				MatchID:       4,
+				MatchDate:     "2026-01-22",
+				MatchTime:     "15:00",
+				HomeTeam:      contract.TeamBrief{ID: 2, Name: "Bravo"},
+				AwayTeam:      contract.TeamBrief{ID: 1, Name: "Alpha"},
+				ExpectedGoals: contract.MatchPredictionGoals{Home: 0.5, Away: 1.16},
+				Probabilities: contract.MatchPredictionOutcome{HomeWin: 0.1604, Draw: 0.3171, AwayWin: 0.5225},
+				LikelyScores: []contract.MatchPredictionScore{
+					{HomeScore: 0, AwayScore: 1, Probability: 0.2196},
+					{HomeScore: 0, AwayScore: 0, Probability: 0.1891},
+					{HomeScore: 0, AwayScore: 2, Probability: 0.1276},
+					{HomeScore: 1, AwayScore: 1, Probability: 0.1107},
+					{HomeScore: 1, AwayScore: 0, Probability: 0.0953},
+				},
+				MatchesAnalysed: 3,
+			},
+		},
+		{
+			name:    "completed match can not be predicted",
+			matches: history,
+			matchID: 1,
+			wantErr: apperrors.ErrMatchNotScheduled,
+		},
+		{
+			name:    "unknown match",
+			matches: history,
+			matchID: 99,
+			wantErr: apperrors.ErrMatchNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &MatchService{matchRepo: &fakeMatchRepo{matches: tt.matches}, teamRepo: teams}
+
+			got, err := svc.GetMatchPrediction(context.Background(), tt.matchID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v; want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMatchPredictionIsReproducible(t *testing.T) {
+	matches := []entity.Match{
+		scheduledMatch(4, 1, 2, "2026-02-01"),
+		completedMatch(1, 1, 2, "2026-01-01", 3, 2),
+		completedMatch(2, 2, 1, "2026-01-08", 0, 0),
+	}
+	svc := &MatchService{
+		matchRepo: &fakeMatchRepo{matches: matches},
+		teamRepo:  &fakeTeamRepo{teams: []entity.Team{testTeam(1, "Alpha"), testTeam(2, "Bravo")}},
+	}
+
+	first, err := svc.GetMatchPrediction(context.Background(), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		again, err := svc.GetMatchPrediction(context.Background(), 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(first, again) {
+			t.Fatalf("prediction changed between calls:\n%+v\n%+v", first, again)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-test/lib/atomic"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+	"math"
+	"time"
+)
+
+type RatingConfig struct {
+	InitialRating float64
+	KFactor       float64
+	HomeAdvantage float64
+	// GoalDiffMultiplier scales the rating change by 1 + m*log2(goal difference)
+	// for wins by two goals or more. Zero ignores the margin of victory.
+	GoalDiffMultiplier float64
+}
+
+type RatingService struct {
+	ratingRepo    RatingRepository
+	teamRepo      TeamRepository
+	matchRepo     MatchRepository
+	atomicSession atomic.AtomicSessionProvider
+	cfg           RatingConfig
+}
+
+func NewRatingService(
+	ratingRepo RatingRepository,
+	teamRepo TeamRepository,
+	matchRepo MatchRepository,
+	atomicSession atomic.AtomicSessionProvider,
+	cfg RatingConfig,
+) *RatingService {
+	return &RatingService{
+		ratingRepo:    ratingRepo,
+		teamRepo:      teamRepo,
+		matchRepo:     matchRepo,
+		atomicSession: atomicSession,
+		cfg:           cfg,
+	}
+}
+
+// RateMatch stores the rating change of both teams for a completed match.
+// Matches played after it were rated without it, so they are rated again.
+// It is meant to run inside the atomic session that completes the match.
+func (s *RatingService) RateMatch(ctx context.Context, match *entity.Match) error {
+	return s.RerateFrom(ctx, match.MatchDate)
+}
+
+// RerateFrom discards the ratings of matches played on or after from and
+// replays those matches, starting from each team's rating before from. Use it
+// when a match in the past is completed or deleted. It must run inside an
+// atomic session; concurrent replays wait for each other.
+func (s *RatingService) RerateFrom(ctx context.Context, from time.Time) error {
+	if err := s.ratingRepo.Lock(ctx); err != nil {
+		return err
+	}
+
+	latest, err := s.ratingRepo.GetLatestBefore(ctx, from)
+	if err != nil {
+		return err
+	}
+	ratings := make(map[int64]float64, len(latest))
+	for _, r := range latest {
+		ratings[r.TeamID] = r.RatingAfter
+	}
+
+	if err := s.ratingRepo.DeleteFrom(ctx, from); err != nil {
+		return err
+	}
+
+	matches, err := s.matchRepo.GetCompletedFrom(ctx, from)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.replay(ctx, matches, ratings)
+	return err
+}
+
+// RecomputeAll discards the stored rating history and replays every completed
+// match in chronological order with the current configuration.
+func (s *RatingService) RecomputeAll(ctx context.Context) (int, error) {
+	rated := 0
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.ratingRepo.Lock(ctx); err != nil {
+			return err
+		}
+		if err := s.ratingRepo.DeleteAll(ctx); err != nil {
+			return err
+		}
+
+		matches, err := s.matchRepo.GetCompleted(ctx)
+		if err != nil {
+			return err
+		}
+
+		rated, err = s.replay(ctx, matches, map[int64]float64{})
+		return err
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("RecomputeAll err: ", err)
+		return 0, err
+	}
+
+	return rated, nil
+}
+
+// replay rates matches in order, starting from ratings (teams missing from it
+// start at the initial rating), and returns how many were rated.
+func (s *RatingService) replay(ctx context.Context, matches []entity.Match, ratings map[int64]float64) (int, error) {
+	ratingOf := func(teamID int64) float64 {
+		if r, ok := ratings[teamID]; ok {
+			return r
+		}
+		return s.cfg.InitialRating
+	}
+
+	rated := 0
+	for i := range matches {
+		m := &matches[i]
+		if m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+
+		homeAfter, awayAfter, err := s.storeRatings(ctx, m, ratingOf(m.HomeTeamID), ratingOf(m.AwayTeamID))
+		if err != nil {
+			return 0, err
+		}
+		ratings[m.HomeTeamID] = homeAfter
+		ratings[m.AwayTeamID] = awayAfter
+		rated++
+	}
+	return rated, nil
+}
+
+func (s *RatingService) GetRankings(ctx context.Context, query contract.RankingsQuery) ([]contract.RankingEntry, error) {
+	rankings, err := s.ratingRepo.GetRankings(ctx, s.cfg.InitialRating, asOfCutoff(query.AsOf))
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.RankingEntry, 0, len(rankings))
+	for i, r := range rankings {
+		response = append(response, contract.RankingEntry{
+			Rank: i + 1,
+			Team: contract.TeamBrief{
+				ID:   r.TeamID,
+				Name: r.TeamName,
+				Logo: r.TeamLogo,
+			},
+			Rating:        r.Rating,
+			LastChange:    r.LastDelta,
+			Matches:       r.Matches,
+			LastMatchDate: formatDatePtr(r.LastMatchDate),
+		})
+	}
+
+	return response, nil
+}
+
+func (s *RatingService) GetTeamRatingHistory(ctx context.Context, teamID int64) (*contract.TeamRatingHistoryResponse, error) {
+	team, err := s.teamRepo.Get(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	history, err := s.ratingRepo.GetHistoryByTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := s.cfg.InitialRating
+	entries := make([]contract.RatingHistoryEntry, 0, len(history))
+	for _, h := range history {
+		entries = append(entries, contract.RatingHistoryEntry{
+			MatchID:   h.MatchID,
+			MatchDate: h.MatchDate.Format("2006-01-02"),
+			Opponent: contract.TeamBrief{
+				ID:   h.OpponentID,
+				Name: h.OpponentName,
+			},
+			RatingBefore: h.RatingBefore,
+			RatingAfter:  h.RatingAfter,
+			Delta:        h.Delta,
+		})
+		current = h.RatingAfter
+	}
+
+	return &contract.TeamRatingHistoryResponse{
+		Team: contract.TeamBrief{
+			ID:   team.ID,
+			Name: team.Name,
+			Logo: team.Logo,
+		},
+		CurrentRating: current,
+		History:       entries,
+	}, nil
+}
+
+func (s *RatingService) storeRatings(ctx context.Context, match *entity.Match, homeRating, awayRating float64) (float64, float64, error) {
+	delta := s.eloDelta(homeRating, awayRating, *match.HomeScore, *match.AwayScore)
+
+	records := []entity.TeamRating{
+		{
+			TeamID:       match.HomeTeamID,
+			OpponentID:   match.AwayTeamID,
+			MatchID:      match.ID,
+			MatchDate:    match.MatchDate,
+			RatingBefore: homeRating,
+			RatingAfter:  roundRating(homeRating + delta),
+			Delta:        delta,
+		},
+		{
+			TeamID:       match.AwayTeamID,
+			OpponentID:   match.HomeTeamID,
+			MatchID:      match.ID,
+			MatchDate:    match.MatchDate,
+			RatingBefore: awayRating,
+			RatingAfter:  roundRating(awayRating - delta),
+			Delta:        -delta,
+		},
+	}
+
+	for i := range records {
+		if _, err := s.ratingRepo.Create(ctx, &records[i]); err != nil {
+			return 0, 0, err
+		}
+	}
+	return records[0].RatingAfter, records[1].RatingAfter, nil
+}
+
+// eloDelta returns the rating points the home team gains (or loses, when
+// negative); the away team moves by the same amount in the other direction.
+func (s *RatingService) eloDelta(homeRating, awayRating float64, homeScore, awayScore int) float64 {
+	diff := homeRating + s.cfg.HomeAdvantage - awayRating
+	expected := 1 / (math.Pow(10, -diff/400) + 1)
+
+	actual := 0.5
+	if homeScore > awayScore {
+		actual = 1
+	} else if homeScore < awayScore {
+		actual = 0
+	}
+
+	multiplier := 1.0
+	if margin := math.Abs(float64(homeScore - awayScore)); margin >= 2 {
+		multiplier += s.cfg.GoalDiffMultiplier * math.Log2(margin)
+	}
+
+	return roundRating(s.cfg.KFactor * multiplier * (actual - expected))
+}
+
+func roundRating(r float64) float64 {
+	return math.Round(r*100) / 100
+}
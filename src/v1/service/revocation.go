@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	"sync"
+	"time"
+)
+
+type RevocationConfig struct {
+	// SyncInterval is how often revocations made by other instances are
+	// loaded from the database.
+	SyncInterval time.Duration
+}
+
+// TokenRevocationStore keeps the jti of every revoked, unexpired access token
+// in memory so authenticating a request does not hit the database. Tokens
+// revoked through this instance are rejected immediately; the set is
+// reloaded every SyncInterval to pick up revocations made elsewhere.
+type TokenRevocationStore struct {
+	revokedTokenRepo RevokedTokenRepository
+	cfg              RevocationConfig
+
+	mu       sync.RWMutex
+	revoked  map[string]time.Time
+	syncMu   sync.Mutex
+	syncedAt time.Time
+}
+
+func NewTokenRevocationStore(revokedTokenRepo RevokedTokenRepository, cfg RevocationConfig) *TokenRevocationStore {
+	return &TokenRevocationStore{
+		revokedTokenRepo: revokedTokenRepo,
+		cfg:              cfg,
+		revoked:          map[string]time.Time{},
+	}
+}
+
+// IsRevoked reports whether the access token with the given jti was revoked.
+// If the database can not be reached the last loaded set is used.
+func (s *TokenRevocationStore) IsRevoked(ctx context.Context, jti string) bool {
+	s.syncIfStale(ctx)
+
+	s.mu.RLock()
+	expiresAt, ok := s.revoked[jti]
+	s.mu.RUnlock()
+
+	return ok && expiresAt.After(time.Now())
+}
+
+// Revoke rejects the access token until it expires.
+func (s *TokenRevocationStore) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" || !expiresAt.After(time.Now()) {
+		return nil
+	}
+
+	if err := s.revokedTokenRepo.Create(ctx, &entity.RevokedToken{JTI: jti, ExpiresAt: expiresAt}); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.revoked[jti] = expiresAt
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *TokenRevocationStore) syncIfStale(ctx context.Context) {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.syncedAt) < s.cfg.SyncInterval {
+		return
+	}
+	// Retry after the interval even when loading fails, so an unreachable
+	// database does not add a query to every request.
+	s.syncedAt = now
+
+	tokens, err := s.revokedTokenRepo.GetActive(ctx)
+	if err != nil {
+		logger.GetLogger(ctx).Error("sync revoked tokens err: ", err)
+		return
+	}
+
+	revoked := make(map[string]time.Time, len(tokens))
+	for _, t := range tokens {
+		revoked[t.JTI] = t.ExpiresAt
+	}
+
+	s.mu.Lock()
+	// Revocations are never undone, so keep the unexpired ones already known;
+	// a local revocation may still be in an uncommitted transaction.
+	for jti, expiresAt := range s.revoked {
+		if expiresAt.After(now) {
+			revoked[jti] = expiresAt
+		}
+	}
+	s.revoked = revoked
+	s.mu.Unlock()
+}
+
+// revokeSession ends the session and revokes its current access token.
+func revokeSession(ctx context.Context, sessionRepo SessionRepository, revocations RevocationStore, session *entity.Session) error {
+	if err := sessionRepo.Revoke(ctx, session.ID); err != nil {
+		return err
+	}
+	return revocations.Revoke(ctx, session.AccessJTI, session.AccessExpiresAt)
+}
+
+// revokeUserSessions ends every session of the user, revokes their current
+// access tokens and deletes their calendar feed token.
+func revokeUserSessions(ctx context.Context, sessionRepo SessionRepository, feedTokenRepo FeedTokenRepository, revocations RevocationStore, userID int64) error {
+	sessions, err := sessionRepo.RevokeByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := feedTokenRepo.DeleteByUser(ctx, userID); err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := revocations.Revoke(ctx, session.AccessJTI, session.AccessExpiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
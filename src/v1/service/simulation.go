@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"go-test/src/entity"
+	"go-test/src/v1/contract"
+)
+
+const defaultSimulationIterations = 10000
+
+type SimulationService struct {
+	teamRepo  TeamRepository
+	matchRepo MatchRepository
+}
+
+func NewSimulationService(teamRepo TeamRepository, matchRepo MatchRepository) *SimulationService {
+	return &SimulationService{
+		teamRepo:  teamRepo,
+		matchRepo: matchRepo,
+	}
+}
+
+// simulatedFixture holds the cumulative goal distributions of a remaining
+// match so each iteration only has to draw two uniforms.
+type simulatedFixture struct {
+	homeTeamID int64
+	awayTeamID int64
+	homeCDF    []float64
+	awayCDF    []float64
+}
+
+type simulationTally struct {
+	positions [][]int
+	points    []int
+}
+
+func newSimulationTally(teams int) *simulationTally {
+	positions := make([][]int, teams)
+	for i := range positions {
+		positions[i] = make([]int, teams)
+	}
+	return &simulationTally{positions: positions, points: make([]int, teams)}
+}
+
+// SimulateSeason plays every scheduled match in the range many times on top
+// of the table of the matches already completed in the range, and reports how
+// often each team finished in each position. The range is the season: results
+// before it count neither for points nor for team strengths. Every iteration draws from its own generator seeded by (seed,
+// iteration), so the result depends only on the seed and not on how the
+// iterations are spread across workers.
+func (s *SimulationService) SimulateSeason(ctx context.Context, req contract.SeasonSimulationRequest) (*contract.SeasonSimulationResponse, error) {
+	from, to, err := parseDateRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	iterations := req.Iterations
+	if iterations == 0 {
+		iterations = defaultSimulationIterations
+	}
+
+	seed := time.Now().UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	teams, err := s.teamRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+
+	teamIDs := make([]int64, 0, len(teams))
+	for _, t := range teams {
+		teamIDs = append(teamIDs, t.ID)
+	}
+
+	completed, err := s.matchRepo.GetCompletedInRange(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := s.matchRepo.GetScheduledInRange(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	base := newStandingsTable(teamIDs)
+	base.applyMatches(completed)
+
+	fixtures := buildSimulatedFixtures(buildStrengthModel(completed), remaining)
+	tally, err := runSimulation(ctx, base, fixtures, iterations, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	relegationSpots := min(req.RelegationSpots, len(teams))
+	results := make([]contract.SeasonSimulationTeam, 0, len(teams))
+	for i, t := range teams {
+		positions := make([]float64, len(teams))
+		for p, count := range tally.positions[i] {
+			positions[p] = roundProbability(float64(count) / float64(iterations))
+		}
+
+		relegated := 0
+		for p := len(teams) - relegationSpots; p < len(teams); p++ {
+			relegated += tally.positions[i][p]
+		}
+
+		results = append(results, contract.SeasonSimulationTeam{
+			Team: contract.TeamBrief{
+				ID:   t.ID,
+				Name: t.Name,
+				Logo: t.Logo,
+			},
+			CurrentPoints:         base.rows[i].Points,
+			ExpectedPoints:        roundRating(float64(tally.points[i]) / float64(iterations)),
+			TitleProbability:      roundProbability(float64(tally.positions[i][0]) / float64(iterations)),
+			RelegationProbability: roundProbability(float64(relegated) / float64(iterations)),
+			PositionProbabilities: positions,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].ExpectedPoints > results[j].ExpectedPoints
+	})
+
+	return &contract.SeasonSimulationResponse{
+		From:             formatDatePtr(from),
+		To:               formatDatePtr(to),
+		Iterations:       iterations,
+		Seed:             seed,
+		RemainingMatches: len(fixtures),
+		Teams:            results,
+	}, nil
+}
+
+func buildSimulatedFixtures(model *strengthModel, matches []entity.Match) []simulatedFixture {
+	fixtures := make([]simulatedFixture, 0, len(matches))
+	for _, m := range matches {
+		homeLambda, awayLambda := model.expectedGoals(m.HomeTeamID, m.AwayTeamID)
+		fixtures = append(fixtures, simulatedFixture{
+			homeTeamID: m.HomeTeamID,
+			awayTeamID: m.AwayTeamID,
+			homeCDF:    poissonCDF(homeLambda, poissonMaxGoals),
+			awayCDF:    poissonCDF(awayLambda, poissonMaxGoals),
+		})
+	}
+	return fixtures
+}
+
+// runSimulation spreads the iterations over a worker pool bounded by
+// GOMAXPROCS. Each worker keeps its own tally, merged once all are done.
+func runSimulation(ctx context.Context, base *standingsTable, fixtures []simulatedFixture, iterations int, seed int64) (*simulationTally, error) {
+	workers := min(runtime.GOMAXPROCS(0), iterations)
+	jobs := make(chan int)
+	tallies := make([]*simulationTally, workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		tallies[w] = newSimulationTally(len(base.rows))
+		wg.Add(1)
+		go func(tally *simulationTally) {
+			defer wg.Done()
+			for iteration := range jobs {
+				simulateIteration(base, fixtures, uint64(seed), uint64(iteration), tally)
+			}
+		}(tallies[w])
+	}
+
+produce:
+	for i := 0; i < iterations; i++ {
+		select {
+		case <-ctx.Done():
+			break produce
+		case jobs <- i:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	total := newSimulationTally(len(base.rows))
+	for _, t := range tallies {
+		for i := range t.positions {
+			total.points[i] += t.points[i]
+			for p, count := range t.positions[i] {
+				total.positions[i][p] += count
+			}
+		}
+	}
+	return total, nil
+}
+
+func simulateIteration(base *standingsTable, fixtures []simulatedFixture, seed, iteration uint64, tally *simulationTally) {
+	rng := rand.New(rand.NewPCG(seed, iteration))
+
+	table := base.clone()
+	for _, f := range fixtures {
+		table.apply(f.homeTeamID, f.awayTeamID, sampleGoals(rng, f.homeCDF), sampleGoals(rng, f.awayCDF))
+	}
+
+	for position, row := range table.sorted() {
+		i := table.index[row.TeamID]
+		tally.positions[i][position]++
+		tally.points[i] += row.Points
+	}
+}
+
+func sampleGoals(rng *rand.Rand, cdf []float64) int {
+	u := rng.Float64()
+	return min(sort.SearchFloat64s(cdf, u), len(cdf)-1)
+}
+
+func poissonCDF(lambda float64, max int) []float64 {
+	pmf := poissonPMF(lambda, max)
+	cdf := make([]float64, len(pmf))
+	total := 0.0
+	for _, p := range pmf {
+		total += p
+	}
+	running := 0.0
+	for k, p := range pmf {
+		running += p / total
+		cdf[k] = running
+	}
+	return cdf
+}
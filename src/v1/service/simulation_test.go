@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"go-test/src/entity"
+	"go-test/src/v1/contract"
+)
+
+func simulationFixtures() (*standingsTable, []simulatedFixture) {
+	completed := []entity.Match{
+		completedMatch(1, 1, 2, "2026-01-01", 2, 0),
+		completedMatch(2, 3, 4, "2026-01-01", 1, 1),
+		completedMatch(3, 2, 3, "2026-01-08", 0, 1),
+		completedMatch(4, 4, 1, "2026-01-08", 2, 2),
+	}
+	remaining := []entity.Match{
+		scheduledMatch(5, 1, 3, "2026-01-15"),
+		scheduledMatch(6, 2, 4, "2026-01-15"),
+		scheduledMatch(7, 3, 1, "2026-01-22"),
+		scheduledMatch(8, 4, 2, "2026-01-22"),
+	}
+
+	base := newStandingsTable([]int64{1, 2, 3, 4})
+	base.applyMatches(completed)
+	return base, buildSimulatedFixtures(buildStrengthModel(completed), remaining)
+}
+
+func TestRunSimulationIsIndependentOfGOMAXPROCS(t *testing.T) {
+	base, fixtures := simulationFixtures()
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+
+	var want *simulationTally
+	for _, procs := range []int{1, 2, 3, 8} {
+		runtime.GOMAXPROCS(procs)
+
+		got, err := runSimulation(context.Background(), base, fixtures, 2000, 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want == nil {
+			want = got
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("GOMAXPROCS=%d gave %+v; GOMAXPROCS=1 gave %+v", procs, got, want)
+		}
+	}
+}
+
+func TestRunSimulationSeeds(t *testing.T) {
+	base, fixtures := simulationFixtures()
+
+	first, err := runSimulation(context.Background(), base, fixtures, 2000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := runSimulation(context.Background(), base, fixtures, 2000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := runSimulation(context.Background(), base, fixtures, 2000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(first, again) {
+		t.Errorf("same seed gave different results:\n%+v\n%+v", first, again)
+	}
+	if reflect.DeepEqual(first, other) {
+		t.Errorf("seeds 1 and 2 gave identical results %+v", first)
+	}
+}
+
+func TestRunSimulationTally(t *testing.T) {
+	base, fixtures := simulationFixtures()
+	iterations := 500
+
+	tally, err := runSimulation(context.Background(), base, fixtures, iterations, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for position := range tally.positions {
+		finished := 0
+		for team := range tally.positions {
+			finished += tally.positions[team][position]
+		}
+		if finished != iterations {
+			t.Errorf("position %d was taken %d times; want %d", position+1, finished, iterations)
+		}
+	}
+	for team, row := range base.rows {
+		// Each team plays 2 of the 4 remaining matches, worth 0 to 6 points.
+		if lo, hi := row.Points*iterations, (row.Points+6)*iterations; tally.points[team] < lo || tally.points[team] > hi {
+			t.Errorf("team %d total points %d outside [%d, %d]", row.TeamID, tally.points[team], lo, hi)
+		}
+	}
+}
+
+func TestRunSimulationCancelled(t *testing.T) {
+	base, fixtures := simulationFixtures()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := runSimulation(ctx, base, fixtures, 1000, 1); err == nil {
+		t.Error("cancelled simulation returned no error")
+	}
+}
+
+func TestSimulateSeasonOnlyCountsTheSeason(t *testing.T) {
+	seed := int64(3)
+	svc := NewSimulationService(
+		&fakeTeamRepo{teams: []entity.Team{testTeam(1, "Alpha"), testTeam(2, "Bravo")}},
+		&fakeMatchRepo{matches: []entity.Match{
+			// Last season: must not count.
+			completedMatch(1, 1, 2, "2025-05-01", 5, 0),
+			completedMatch(2, 1, 2, "2025-05-08", 3, 0),
+			// This season.
+			completedMatch(3, 2, 1, "2025-08-10", 1, 0),
+			scheduledMatch(4, 1, 2, "2025-09-01"),
+		}},
+	)
+
+	resp, err := svc.SimulateSeason(context.Background(), contract.SeasonSimulationRequest{
+		From:       "2025-08-01",
+		To:         "2026-05-31",
+		Iterations: 100,
+		Seed:       &seed,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	points := map[int64]int{}
+	for _, team := range resp.Teams {
+		points[team.Team.ID] = team.CurrentPoints
+	}
+	if want := map[int64]int{1: 0, 2: 3}; !reflect.DeepEqual(points, want) {
+		t.Errorf("current points = %v; want %v", points, want)
+	}
+	if resp.RemainingMatches != 1 {
+		t.Errorf("remaining matches = %d; want 1", resp.RemainingMatches)
+	}
+}
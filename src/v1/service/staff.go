@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-test/lib/atomic"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+	"time"
+)
+
+type StaffService struct {
+	staffRepo     StaffRepository
+	teamRepo      TeamRepository
+	atomicSession atomic.AtomicSessionProvider
+}
+
+func NewStaffService(staffRepo StaffRepository, teamRepo TeamRepository, atomicSession atomic.AtomicSessionProvider) *StaffService {
+	return &StaffService{
+		staffRepo:     staffRepo,
+		teamRepo:      teamRepo,
+		atomicSession: atomicSession,
+	}
+}
+
+func (s *StaffService) CreateStaff(ctx context.Context, req contract.CreateStaffRequest, scope *contract.TeamScope) (*contract.StaffResponse, error) {
+	if err := s.checkTeamExists(ctx, req.TeamID); err != nil {
+		return nil, err
+	}
+	if !scope.Allows(req.TeamID) {
+		return nil, apperrors.ErrForbidden
+	}
+
+	member := &entity.StaffMember{
+		TeamID:    req.TeamID,
+		Name:      req.Name,
+		Role:      entity.StaffRole(req.Role),
+		StartDate: parseDate(req.StartDate),
+	}
+	if req.EndDate != "" {
+		endDate := parseDate(req.EndDate)
+		member.EndDate = &endDate
+	}
+
+	if err := s.checkTenure(ctx, member); err != nil {
+		return nil, err
+	}
+
+	var staffID int64
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		id, err := s.staffRepo.Create(ctx, member)
+		if err != nil {
+			return err
+		}
+		staffID = id
+		return nil
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("CreateStaff err: ", err)
+		return nil, err
+	}
+
+	member.ID = staffID
+
+	return staffToResponse(member), nil
+}
+
+func (s *StaffService) GetStaff(ctx context.Context, id int64) (*contract.StaffResponse, error) {
+	member, err := s.staffRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrStaffNotFound
+		}
+		return nil, err
+	}
+
+	return staffToResponse(&member), nil
+}
+
+func (s *StaffService) GetAllStaff(ctx context.Context) ([]contract.StaffResponse, error) {
+	staff, err := s.staffRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.StaffResponse, 0, len(staff))
+	for _, m := range staff {
+		response = append(response, *staffToResponse(&m))
+	}
+
+	return response, nil
+}
+
+// GetStaffHistory lists the staff of a team whose tenure ended before today,
+// most recent first.
+func (s *StaffService) GetStaffHistory(ctx context.Context, teamID int64) ([]contract.StaffResponse, error) {
+	if err := s.checkTeamExists(ctx, teamID); err != nil {
+		return nil, err
+	}
+
+	staff, err := s.staffRepo.GetHistoryByTeam(ctx, teamID, today())
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.StaffResponse, 0, len(staff))
+	for _, m := range staff {
+		response = append(response, *staffToResponse(&m))
+	}
+
+	return response, nil
+}
+
+func (s *StaffService) UpdateStaff(ctx context.Context, id int64, req contract.UpdateStaffRequest, scope *contract.TeamScope) (*contract.StaffResponse, error) {
+	member, err := s.staffRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrStaffNotFound
+		}
+		return nil, err
+	}
+	if !scope.Allows(member.TeamID) {
+		return nil, apperrors.ErrForbidden
+	}
+
+	if req.TeamID > 0 {
+		if err := s.checkTeamExists(ctx, req.TeamID); err != nil {
+			return nil, err
+		}
+		if !scope.Allows(req.TeamID) {
+			return nil, apperrors.ErrForbidden
+		}
+		member.TeamID = req.TeamID
+	}
+	if req.Name != "" {
+		member.Name = req.Name
+	}
+	if req.Role != "" {
+		member.Role = entity.StaffRole(req.Role)
+	}
+	if req.StartDate != "" {
+		member.StartDate = parseDate(req.StartDate)
+	}
+	if req.EndDate != "" {
+		endDate := parseDate(req.EndDate)
+		member.EndDate = &endDate
+	}
+	if req.ClearEndDate {
+		member.EndDate = nil
+	}
+
+	if err := s.checkTenure(ctx, &member); err != nil {
+		return nil, err
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.staffRepo.Update(ctx, &member)
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("UpdateStaff err: ", err)
+		return nil, err
+	}
+
+	return staffToResponse(&member), nil
+}
+
+func (s *StaffService) DeleteStaff(ctx context.Context, id int64, scope *contract.TeamScope) error {
+	member, err := s.staffRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrStaffNotFound
+		}
+		return err
+	}
+	if !scope.Allows(member.TeamID) {
+		return apperrors.ErrForbidden
+	}
+
+	return atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.staffRepo.Delete(ctx, id)
+	})
+}
+
+// checkTenure validates the tenure dates. A team has at most one head coach
+// at any time, so head coach tenures of the same team may not overlap.
+func (s *StaffService) checkTenure(ctx context.Context, member *entity.StaffMember) error {
+	if member.EndDate != nil && member.EndDate.Before(member.StartDate) {
+		return apperrors.ErrInvalidDateRange
+	}
+
+	if member.Role != entity.StaffRoleHeadCoach {
+		return nil
+	}
+
+	overlap, err := s.staffRepo.HasOverlappingTenure(ctx, member.TeamID, member.Role, member.StartDate, member.EndDate, member.ID)
+	if err != nil {
+		return err
+	}
+	if overlap {
+		return apperrors.ErrStaffTenureOverlap
+	}
+	return nil
+}
+
+func (s *StaffService) checkTeamExists(ctx context.Context, teamID int64) error {
+	_, err := s.teamRepo.Get(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrTeamNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// today returns the current date at midnight UTC, the way dates are stored.
+func today() time.Time {
+	return parseDate(time.Now().Format("2006-01-02"))
+}
+
+func staffToResponse(m *entity.StaffMember) *contract.StaffResponse {
+	return &contract.StaffResponse{
+		ID:        m.ID,
+		TeamID:    m.TeamID,
+		Name:      m.Name,
+		Role:      string(m.Role),
+		StartDate: m.StartDate.Format("2006-01-02"),
+		EndDate:   formatDatePtr(m.EndDate),
+		CreatedAt: m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
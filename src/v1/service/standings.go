@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+)
+
+const (
+	pointsWin  = 3
+	pointsDraw = 1
+)
+
+type standingRow struct {
+	TeamID       int64
+	Played       int
+	Wins         int
+	Draws        int
+	Losses       int
+	GoalsFor     int
+	GoalsAgainst int
+	Points       int
+}
+
+func (r standingRow) goalDiff() int {
+	return r.GoalsFor - r.GoalsAgainst
+}
+
+// standingsTable is an in-memory league table keyed by team. Results for
+// teams outside the table (e.g. soft-deleted teams) are ignored.
+type standingsTable struct {
+	rows  []standingRow
+	index map[int64]int
+}
+
+func newStandingsTable(teamIDs []int64) *standingsTable {
+	t := &standingsTable{
+		rows:  make([]standingRow, len(teamIDs)),
+		index: make(map[int64]int, len(teamIDs)),
+	}
+	for i, id := range teamIDs {
+		t.rows[i].TeamID = id
+		t.index[id] = i
+	}
+	return t
+}
+
+func (t *standingsTable) clone() *standingsTable {
+	rows := make([]standingRow, len(t.rows))
+	copy(rows, t.rows)
+	return &standingsTable{rows: rows, index: t.index}
+}
+
+func (t *standingsTable) applyMatches(matches []entity.Match) {
+	for _, m := range matches {
+		if m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		t.apply(m.HomeTeamID, m.AwayTeamID, *m.HomeScore, *m.AwayScore)
+	}
+}
+
+func (t *standingsTable) apply(homeTeamID, awayTeamID int64, homeScore, awayScore int) {
+	hi, okHome := t.index[homeTeamID]
+	ai, okAway := t.index[awayTeamID]
+	if !okHome || !okAway {
+		return
+	}
+
+	home, away := &t.rows[hi], &t.rows[ai]
+	home.Played++
+	away.Played++
+	home.GoalsFor += homeScore
+	home.GoalsAgainst += awayScore
+	away.GoalsFor += awayScore
+	away.GoalsAgainst += homeScore
+
+	switch {
+	case homeScore > awayScore:
+		home.Wins++
+		home.Points += pointsWin
+		away.Losses++
+	case homeScore < awayScore:
+		away.Wins++
+		away.Points += pointsWin
+		home.Losses++
+	default:
+		home.Draws++
+		away.Draws++
+		home.Points += pointsDraw
+		away.Points += pointsDraw
+	}
+}
+
+// sorted returns the table ordered by points, goal difference, goals scored
+// and finally team ID so that the order is always deterministic.
+func (t *standingsTable) sorted() []standingRow {
+	rows := make([]standingRow, len(t.rows))
+	copy(rows, t.rows)
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.goalDiff() != b.goalDiff() {
+			return a.goalDiff() > b.goalDiff()
+		}
+		if a.GoalsFor != b.GoalsFor {
+			return a.GoalsFor > b.GoalsFor
+		}
+		return a.TeamID < b.TeamID
+	})
+	return rows
+}
+
+type StandingsService struct {
+	teamRepo  TeamRepository
+	matchRepo MatchRepository
+}
+
+func NewStandingsService(teamRepo TeamRepository, matchRepo MatchRepository) *StandingsService {
+	return &StandingsService{
+		teamRepo:  teamRepo,
+		matchRepo: matchRepo,
+	}
+}
+
+// GetStandings returns the league table. With as_of it is rebuilt from the
+// teams and matches that existed at the end of that day, so a table published
+// in the past can be reproduced even after later deletions.
+func (s *StandingsService) GetStandings(ctx context.Context, query contract.StandingsQuery) ([]contract.StandingEntry, error) {
+	teams, table, err := s.tableAsOf(ctx, asOfCutoff(query.AsOf))
+	if err != nil {
+		return nil, err
+	}
+
+	return standingEntries(table.sorted(), teams), nil
+}
+
+// GetWhatIfStandings returns the table as it would stand if the given
+// scheduled matches ended with the given scores. Nothing is persisted: the
+// hypothetical results are applied to an in-memory copy of the real table.
+func (s *StandingsService) GetWhatIfStandings(ctx context.Context, req contract.WhatIfStandingsRequest) (*contract.WhatIfStandingsResponse, error) {
+	seen := make(map[int64]bool, len(req.Results))
+	hypothetical := make([]entity.Match, 0, len(req.Results))
+	for _, r := range req.Results {
+		if seen[r.MatchID] {
+			return nil, apperrors.ErrInvalidRequest
+		}
+		seen[r.MatchID] = true
+
+		match, err := s.matchRepo.Get(ctx, r.MatchID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, apperrors.ErrMatchNotFound
+			}
+			return nil, err
+		}
+		if match.Status != entity.MatchStatusScheduled {
+			return nil, apperrors.ErrMatchNotScheduled
+		}
+
+		homeScore, awayScore := r.HomeScore, r.AwayScore
+		match.HomeScore = &homeScore
+		match.AwayScore = &awayScore
+		hypothetical = append(hypothetical, match)
+	}
+
+	teams, current, err := s.tableAsOf(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRows := current.sorted()
+	currentPosition := make(map[int64]int, len(currentRows))
+	currentPoints := make(map[int64]int, len(currentRows))
+	for i, row := range currentRows {
+		currentPosition[row.TeamID] = i + 1
+		currentPoints[row.TeamID] = row.Points
+	}
+
+	projected := current.clone()
+	projected.applyMatches(hypothetical)
+
+	entries := standingEntries(projected.sorted(), teams)
+	table := make([]contract.WhatIfStandingEntry, 0, len(entries))
+	for _, e := range entries {
+		table = append(table, contract.WhatIfStandingEntry{
+			StandingEntry:   e,
+			CurrentPosition: currentPosition[e.Team.ID],
+			PointsGained:    e.Points - currentPoints[e.Team.ID],
+		})
+	}
+
+	return &contract.WhatIfStandingsResponse{
+		Results: req.Results,
+		Table:   table,
+	}, nil
+}
+
+func (s *StandingsService) tableAsOf(ctx context.Context, asOf *time.Time) (map[int64]entity.Team, *standingsTable, error) {
+	var teams []entity.Team
+	var completed []entity.Match
+	var err error
+
+	if asOf != nil {
+		teams, err = s.teamRepo.GetListAsOf(ctx, *asOf)
+	} else {
+		teams, err = s.teamRepo.GetList(ctx)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if asOf != nil {
+		completed, err = s.matchRepo.GetCompletedAsOf(ctx, *asOf)
+	} else {
+		completed, err = s.matchRepo.GetCompleted(ctx)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teamsByID := make(map[int64]entity.Team, len(teams))
+	teamIDs := make([]int64, 0, len(teams))
+	for _, t := range teams {
+		teamsByID[t.ID] = t
+		teamIDs = append(teamIDs, t.ID)
+	}
+
+	table := newStandingsTable(teamIDs)
+	table.applyMatches(completed)
+	return teamsByID, table, nil
+}
+
+func standingEntries(rows []standingRow, teams map[int64]entity.Team) []contract.StandingEntry {
+	entries := make([]contract.StandingEntry, 0, len(rows))
+	for i, row := range rows {
+		team := teams[row.TeamID]
+		entries = append(entries, contract.StandingEntry{
+			Position: i + 1,
+			Team: contract.TeamBrief{
+				ID:   team.ID,
+				Name: team.Name,
+				Logo: team.Logo,
+			},
+			Played:         row.Played,
+			Wins:           row.Wins,
+			Draws:          row.Draws,
+			Losses:         row.Losses,
+			GoalsFor:       row.GoalsFor,
+			GoalsAgainst:   row.GoalsAgainst,
+			GoalDifference: row.goalDiff(),
+			Points:         row.Points,
+		})
+	}
+	return entries
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"math"
+
+	"go-test/src/entity"
+)
+
+const (
+	// strengthPriorMatches is the number of league-average matches blended into
+	// every team's record so that teams with few results are not rated extreme.
+	strengthPriorMatches = 3
+
+	// Fallback goal averages used before any match has been completed.
+	defaultHomeGoalsAvg = 1.5
+	defaultAwayGoalsAvg = 1.2
+
+	// poissonMaxGoals bounds the score grid; the tail beyond it is negligible.
+	poissonMaxGoals = 10
+)
+
+type teamStrength struct {
+	Attack  float64
+	Defence float64
+}
+
+// strengthModel is an independent Poisson goal model: each side's expected
+// goals is the league average for its venue scaled by its own attack and the
+// opponent's defence, both relative to the league average (1.0).
+type strengthModel struct {
+	homeGoalsAvg float64
+	awayGoalsAvg float64
+	teams        map[int64]teamStrength
+	sampleSize   int
+}
+
+func buildStrengthModel(matches []entity.Match) *strengthModel {
+	type tally struct {
+		played   int
+		scored   int
+		conceded int
+	}
+
+	tallies := make(map[int64]*tally)
+	tallyOf := func(teamID int64) *tally {
+		t, ok := tallies[teamID]
+		if !ok {
+			t = &tally{}
+			tallies[teamID] = t
+		}
+		return t
+	}
+
+	var homeGoals, awayGoals, played int
+	for _, m := range matches {
+		if m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		played++
+		homeGoals += *m.HomeScore
+		awayGoals += *m.AwayScore
+
+		home, away := tallyOf(m.HomeTeamID), tallyOf(m.AwayTeamID)
+		home.played++
+		home.scored += *m.HomeScore
+		home.conceded += *m.AwayScore
+		away.played++
+		away.scored += *m.AwayScore
+		away.conceded += *m.HomeScore
+	}
+
+	model := &strengthModel{
+		homeGoalsAvg: defaultHomeGoalsAvg,
+		awayGoalsAvg: defaultAwayGoalsAvg,
+		teams:        make(map[int64]teamStrength, len(tallies)),
+		sampleSize:   played,
+	}
+	if played == 0 {
+		return model
+	}
+
+	model.homeGoalsAvg = float64(homeGoals) / float64(played)
+	model.awayGoalsAvg = float64(awayGoals) / float64(played)
+
+	goalsPerTeamMatch := float64(homeGoals+awayGoals) / float64(2*played)
+	if goalsPerTeamMatch == 0 {
+		return model
+	}
+
+	prior := strengthPriorMatches * goalsPerTeamMatch
+	for teamID, t := range tallies {
+		games := float64(t.played + strengthPriorMatches)
+		model.teams[teamID] = teamStrength{
+			Attack:  (float64(t.scored) + prior) / games / goalsPerTeamMatch,
+			Defence: (float64(t.conceded) + prior) / games / goalsPerTeamMatch,
+		}
+	}
+
+	return model
+}
+
+func (m *strengthModel) strengthOf(teamID int64) teamStrength {
+	if s, ok := m.teams[teamID]; ok {
+		return s
+	}
+	return teamStrength{Attack: 1, Defence: 1}
+}
+
+// expectedGoals returns the Poisson means for the home and away side.
+func (m *strengthModel) expectedGoals(homeTeamID, awayTeamID int64) (float64, float64) {
+	home, away := m.strengthOf(homeTeamID), m.strengthOf(awayTeamID)
+	return m.homeGoalsAvg * home.Attack * away.Defence,
+		m.awayGoalsAvg * away.Attack * home.Defence
+}
+
+// scoreGrid returns P(home = i, away = j) for 0 <= i, j <= poissonMaxGoals,
+// normalised so the truncated grid sums to one.
+func scoreGrid(homeLambda, awayLambda float64) [][]float64 {
+	homePMF := poissonPMF(homeLambda, poissonMaxGoals)
+	awayPMF := poissonPMF(awayLambda, poissonMaxGoals)
+
+	grid := make([][]float64, poissonMaxGoals+1)
+	total := 0.0
+	for i := range grid {
+		grid[i] = make([]float64, poissonMaxGoals+1)
+		for j := range grid[i] {
+			grid[i][j] = homePMF[i] * awayPMF[j]
+			total += grid[i][j]
+		}
+	}
+
+	for i := range grid {
+		for j := range grid[i] {
+			grid[i][j] /= total
+		}
+	}
+	return grid
+}
+
+func poissonPMF(lambda float64, max int) []float64 {
+	pmf := make([]float64, max+1)
+	pmf[0] = math.Exp(-lambda)
+	for k := 1; k <= max; k++ {
+		pmf[k] = pmf[k-1] * lambda / float64(k)
+	}
+	return pmf
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"go-test/src/entity"
+)
+
+func TestBuildStrengthModel(t *testing.T) {
+	tests := []struct {
+		name         string
+		matches      []entity.Match
+		homeGoalsAvg float64
+		awayGoalsAvg float64
+		sampleSize   int
+		teams        map[int64]teamStrength
+	}{
+		{
+			name:         "no results uses the default averages",
+			homeGoalsAvg: defaultHomeGoalsAvg,
+			awayGoalsAvg: defaultAwayGoalsAvg,
+			teams:        map[int64]teamStrength{},
+		},
+		{
+			name: "scheduled matches are ignored",
+			matches: []entity.Match{
+				scheduledMatch(1, 1, 2, "2026-01-01"),
+			},
+			homeGoalsAvg: defaultHomeGoalsAvg,
+			awayGoalsAvg: defaultAwayGoalsAvg,
+			teams:        map[int64]teamStrength{},
+		},
+		{
+			// One match is far fewer than the 3 prior matches, so the prior
+			// dominates: with 2 goals per team per match, 4-0 gives the winner
+			// an attack of (4+6)/4/2 = 1.25 rather than 2.
+			name: "a single result is pulled towards the league average",
+			matches: []entity.Match{
+				completedMatch(1, 1, 2, "2026-01-01", 4, 0),
+			},
+			homeGoalsAvg: 4,
+			awayGoalsAvg: 0,
+			sampleSize:   1,
+			teams: map[int64]teamStrength{
+				1: {Attack: 1.25, Defence: 0.75},
+				2: {Attack: 0.75, Defence: 1.25},
+			},
+		},
+		{
+			// 3 matches, 5 goals: 5/6 goals per team per match, prior 2.5.
+			// Team 1 scored 3 and conceded 0 in 2: (3+2.5)/5/(5/6) = 1.32.
+			name: "three results with the prior of three matches",
+			matches: []entity.Match{
+				completedMatch(1, 1, 2, "2026-01-01", 2, 0),
+				completedMatch(2, 2, 3, "2026-01-08", 1, 1),
+				completedMatch(3, 3, 1, "2026-01-15", 0, 1),
+			},
+			homeGoalsAvg: 1,
+			awayGoalsAvg: 2.0 / 3,
+			sampleSize:   3,
+			teams: map[int64]teamStrength{
+				1: {Attack: 1.32, Defence: 0.6},
+				2: {Attack: 0.84, Defence: 1.32},
+				3: {Attack: 0.84, Defence: 1.08},
+			},
+		},
+		{
+			name: "goalless league keeps teams at the average",
+			matches: []entity.Match{
+				completedMatch(1, 1, 2, "2026-01-01", 0, 0),
+			},
+			homeGoalsAvg: 0,
+			awayGoalsAvg: 0,
+			sampleSize:   1,
+			teams:        map[int64]teamStrength{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := buildStrengthModel(tt.matches)
+
+			if !almostEqual(model.homeGoalsAvg, tt.homeGoalsAvg) || !almostEqual(model.awayGoalsAvg, tt.awayGoalsAvg) {
+				t.Errorf("goal averages = %v, %v; want %v, %v", model.homeGoalsAvg, model.awayGoalsAvg, tt.homeGoalsAvg, tt.awayGoalsAvg)
+			}
+			if model.sampleSize != tt.sampleSize {
+				t.Errorf("sampleSize = %d; want %d", model.sampleSize, tt.sampleSize)
+			}
+			if len(model.teams) != len(tt.teams) {
+				t.Fatalf("got %d teams; want %d", len(model.teams), len(tt.teams))
+			}
+			for id, want := range tt.teams {
+				got := model.teams[id]
+				if !almostEqual(got.Attack, want.Attack) || !almostEqual(got.Defence, want.Defence) {
+					t.Errorf("team %d = %+v; want %+v", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStrengthOfUnknownTeamIsAverage(t *testing.T) {
+	model := buildStrengthModel([]entity.Match{completedMatch(1, 1, 2, "2026-01-01", 2, 1)})
+
+	if got := model.strengthOf(99); got != (teamStrength{Attack: 1, Defence: 1}) {
+		t.Errorf("strengthOf(99) = %+v; want attack and defence 1", got)
+	}
+}
+
+func TestScoreGrid(t *testing.T) {
+	tests := []struct {
+		name       string
+		homeLambda float64
+		awayLambda float64
+	}{
+		{name: "typical match", homeLambda: 1.5, awayLambda: 1.2},
+		{name: "goalless teams", homeLambda: 0, awayLambda: 0},
+		{name: "lopsided", homeLambda: 0.3, awayLambda: 3.1},
+		// Much of the mass lies beyond 10 goals and is normalised away.
+		{name: "high scoring", homeLambda: 9, awayLambda: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grid := scoreGrid(tt.homeLambda, tt.awayLambda)
+
+			if len(grid) != poissonMaxGoals+1 {
+				t.Fatalf("grid has %d rows; want %d", len(grid), poissonMaxGoals+1)
+			}
+			total := 0.0
+			for i, row := range grid {
+				if len(row) != poissonMaxGoals+1 {
+					t.Fatalf("row %d has %d columns; want %d", i, len(row), poissonMaxGoals+1)
+				}
+				for j, p := range row {
+					if p < 0 || p > 1 {
+						t.Errorf("P(%d-%d) = %v; want a probability", i, j, p)
+					}
+					total += p
+				}
+			}
+			if !almostEqual(total, 1) {
+				t.Errorf("grid sums to %v; want 1", total)
+			}
+		})
+	}
+}
+
+func TestScoreGridMatchesPoisson(t *testing.T) {
+	grid := scoreGrid(1, 1)
+
+	// With lambda 1 the tail beyond 10 goals is below 1e-8, so the cells are
+	// the plain Poisson probabilities: P(0-0) = e^-2, P(1-0) = e^-2.
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+		if got := grid[cell[0]][cell[1]]; math.Abs(got-math.Exp(-2)) > 1e-7 {
+			t.Errorf("P(%d-%d) = %v; want %v", cell[0], cell[1], got, math.Exp(-2))
+		}
+	}
+	if got, want := grid[2][0], math.Exp(-2)/2; math.Abs(got-want) > 1e-7 {
+		t.Errorf("P(2-0) = %v; want %v", got, want)
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
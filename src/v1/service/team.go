@@ -9,16 +9,32 @@ import (
 	"go-test/src/entity"
 	apperrors "go-test/src/errors"
 	"go-test/src/v1/contract"
+	"time"
 )
 
 type TeamService struct {
 	teamRepo      TeamRepository
+	matchRepo     MatchRepository
+	goalRepo      GoalRepository
+	venueRepo     VenueRepository
+	staffRepo     StaffRepository
 	atomicSession atomic.AtomicSessionProvider
 }
 
-func NewTeamService(teamRepo TeamRepository, atomicSession atomic.AtomicSessionProvider) *TeamService {
+func NewTeamService(
+	teamRepo TeamRepository,
+	matchRepo MatchRepository,
+	goalRepo GoalRepository,
+	venueRepo VenueRepository,
+	staffRepo StaffRepository,
+	atomicSession atomic.AtomicSessionProvider,
+) *TeamService {
 	return &TeamService{
 		teamRepo:      teamRepo,
+		matchRepo:     matchRepo,
+		goalRepo:      goalRepo,
+		venueRepo:     venueRepo,
+		staffRepo:     staffRepo,
 		atomicSession: atomicSession,
 	}
 }
@@ -31,6 +47,12 @@ func (s *TeamService) CreateTeam(ctx context.Context, req contract.CreateTeamReq
 		Address:     req.Address,
 		City:        req.City,
 	}
+	if req.HomeVenueID > 0 {
+		if err := s.checkVenueExists(ctx, req.HomeVenueID); err != nil {
+			return nil, err
+		}
+		team.HomeVenueID = &req.HomeVenueID
+	}
 
 	var teamID int64
 	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
@@ -52,7 +74,7 @@ func (s *TeamService) CreateTeam(ctx context.Context, req contract.CreateTeamReq
 	return teamToResponse(team), nil
 }
 
-func (s *TeamService) GetTeam(ctx context.Context, id int64) (*contract.TeamResponse, error) {
+func (s *TeamService) GetTeam(ctx context.Context, id int64, query contract.TeamQuery) (*contract.TeamResponse, error) {
 	team, err := s.teamRepo.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -61,10 +83,29 @@ func (s *TeamService) GetTeam(ctx context.Context, id int64) (*contract.TeamResp
 		return nil, err
 	}
 
-	return teamToResponse(&team), nil
+	response := teamToResponse(&team)
+
+	staff, err := s.staffRepo.GetByTeamOnDate(ctx, team.ID, today())
+	if err != nil {
+		return nil, err
+	}
+	response.Staff = make([]contract.StaffResponse, 0, len(staff))
+	for _, m := range staff {
+		response.Staff = append(response.Staff, *staffToResponse(&m))
+	}
+
+	if query.Include == "form" {
+		form, err := s.buildTeamForm(ctx, team.ID, query.FormLast, nil)
+		if err != nil {
+			return nil, err
+		}
+		response.Form = form
+	}
+
+	return response, nil
 }
 
-func (s *TeamService) GetAllTeams(ctx context.Context) ([]contract.TeamResponse, error) {
+func (s *TeamService) GetAllTeams(ctx context.Context, query contract.TeamQuery) ([]contract.TeamResponse, error) {
 	teams, err := s.teamRepo.GetList(ctx)
 	if err != nil {
 		return nil, err
@@ -72,13 +113,21 @@ func (s *TeamService) GetAllTeams(ctx context.Context) ([]contract.TeamResponse,
 
 	response := make([]contract.TeamResponse, 0, len(teams))
 	for _, t := range teams {
-		response = append(response, *teamToResponse(&t))
+		teamResp := teamToResponse(&t)
+		if query.Include == "form" {
+			form, err := s.buildTeamForm(ctx, t.ID, query.FormLast, nil)
+			if err != nil {
+				return nil, err
+			}
+			teamResp.Form = form
+		}
+		response = append(response, *teamResp)
 	}
 
 	return response, nil
 }
 
-func (s *TeamService) UpdateTeam(ctx context.Context, id int64, req contract.UpdateTeamRequest) (*contract.TeamResponse, error) {
+func (s *TeamService) UpdateTeam(ctx context.Context, id int64, req contract.UpdateTeamRequest, scope *contract.TeamScope) (*contract.TeamResponse, error) {
 	team, err := s.teamRepo.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -86,6 +135,9 @@ func (s *TeamService) UpdateTeam(ctx context.Context, id int64, req contract.Upd
 		}
 		return nil, err
 	}
+	if !scope.Allows(team.ID) {
+		return nil, apperrors.ErrForbidden
+	}
 
 	if req.Name != "" {
 		team.Name = req.Name
@@ -102,6 +154,12 @@ func (s *TeamService) UpdateTeam(ctx context.Context, id int64, req contract.Upd
 	if req.City != "" {
 		team.City = req.City
 	}
+	if req.HomeVenueID > 0 {
+		if err := s.checkVenueExists(ctx, req.HomeVenueID); err != nil {
+			return nil, err
+		}
+		team.HomeVenueID = &req.HomeVenueID
+	}
 
 	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
 		return s.teamRepo.Update(ctx, &team)
@@ -129,6 +187,336 @@ func (s *TeamService) DeleteTeam(ctx context.Context, id int64) error {
 	})
 }
 
+func (s *TeamService) GetTeamStats(ctx context.Context, id int64, req contract.TeamStatsRequest) (*contract.TeamStatsResponse, error) {
+	asOf := asOfCutoff(req.AsOf)
+	team, err := s.teamAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := parseDateRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := s.matchRepo.GetTeamStats(ctx, id, from, to, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	home := contract.TeamRecord{
+		Played: stat.HomeWins + stat.HomeDraws + stat.HomeLosses,
+		Wins:   stat.HomeWins,
+		Draws:  stat.HomeDraws,
+		Losses: stat.HomeLosses,
+	}
+	away := contract.TeamRecord{
+		Played: stat.AwayWins + stat.AwayDraws + stat.AwayLosses,
+		Wins:   stat.AwayWins,
+		Draws:  stat.AwayDraws,
+		Losses: stat.AwayLosses,
+	}
+
+	return &contract.TeamStatsResponse{
+		Team: contract.TeamBrief{
+			ID:   team.ID,
+			Name: team.Name,
+			Logo: team.Logo,
+		},
+		From: req.From,
+		To:   req.To,
+		AsOf: req.AsOf,
+		Overall: contract.TeamRecord{
+			Played: stat.Played,
+			Wins:   home.Wins + away.Wins,
+			Draws:  home.Draws + away.Draws,
+			Losses: home.Losses + away.Losses,
+		},
+		Home:              home,
+		Away:              away,
+		GoalsFor:          stat.GoalsFor,
+		GoalsAgainst:      stat.GoalsAgainst,
+		GoalDifference:    stat.GoalsFor - stat.GoalsAgainst,
+		CleanSheets:       stat.CleanSheets,
+		FailedToScore:     stat.FailedToScore,
+		BiggestWin:        teamStatMatchToResponse(stat.BiggestWin),
+		BiggestLoss:       teamStatMatchToResponse(stat.BiggestLoss),
+		AverageGoalMinute: stat.AverageGoalMinute,
+	}, nil
+}
+
+func (s *TeamService) GetHeadToHead(ctx context.Context, id, opponentID int64, req contract.HeadToHeadRequest) (*contract.HeadToHeadResponse, error) {
+	if id == opponentID {
+		return nil, apperrors.ErrSameTeamMatch
+	}
+
+	asOf := asOfCutoff(req.AsOf)
+	team, err := s.teamAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, err
+	}
+	opponent, err := s.teamAsOf(ctx, opponentID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.matchRepo.GetHeadToHeadSummary(ctx, id, opponentID, nil, asOf, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.GetHeadToHead(ctx, id, opponentID, nil, asOf, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	scorers, err := s.goalRepo.GetHeadToHeadScorers(ctx, id, opponentID, 5, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings := make([]contract.HeadToHeadMatch, 0, len(matches))
+	for _, m := range matches {
+		meetings = append(meetings, headToHeadMatchToResponse(&m, id))
+	}
+
+	lastResults := meetings
+	if len(lastResults) > 5 {
+		lastResults = lastResults[:5]
+	}
+
+	topScorers := make([]contract.HeadToHeadScorer, 0, len(scorers))
+	for _, sc := range scorers {
+		topScorers = append(topScorers, contract.HeadToHeadScorer{
+			PlayerID: sc.PlayerID,
+			Name:     sc.PlayerName,
+			TeamID:   sc.TeamID,
+			Goals:    sc.Goals,
+		})
+	}
+
+	return &contract.HeadToHeadResponse{
+		Team: contract.HeadToHeadSide{
+			Team: contract.TeamBrief{ID: team.ID, Name: team.Name, Logo: team.Logo},
+			Record: contract.TeamRecord{
+				Played: summary.Played,
+				Wins:   summary.TeamWins,
+				Draws:  summary.Draws,
+				Losses: summary.OpponentWins,
+			},
+			Goals: summary.TeamGoals,
+		},
+		Opponent: contract.HeadToHeadSide{
+			Team: contract.TeamBrief{ID: opponent.ID, Name: opponent.Name, Logo: opponent.Logo},
+			Record: contract.TeamRecord{
+				Played: summary.Played,
+				Wins:   summary.OpponentWins,
+				Draws:  summary.Draws,
+				Losses: summary.TeamWins,
+			},
+			Goals: summary.OpponentGoals,
+		},
+		AsOf:        req.AsOf,
+		Played:      summary.Played,
+		LastResults: lastResults,
+		Meetings:    meetings,
+		TopScorers:  topScorers,
+	}, nil
+}
+
+func (s *TeamService) GetTeamForm(ctx context.Context, id int64, req contract.TeamFormRequest) (*contract.TeamFormResponse, error) {
+	asOf := asOfCutoff(req.AsOf)
+	team, err := s.teamAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := s.buildTeamForm(ctx, id, req.Last, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contract.TeamFormResponse{
+		Team:     contract.TeamBrief{ID: team.ID, Name: team.Name, Logo: team.Logo},
+		AsOf:     req.AsOf,
+		TeamForm: *form,
+	}, nil
+}
+
+// teamAsOf returns the team as it existed at the cutoff, or the live team
+// when asOf is nil.
+func (s *TeamService) teamAsOf(ctx context.Context, id int64, asOf *time.Time) (entity.Team, error) {
+	var team entity.Team
+	var err error
+	if asOf != nil {
+		team, err = s.teamRepo.GetAsOf(ctx, id, *asOf)
+	} else {
+		team, err = s.teamRepo.Get(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return team, apperrors.ErrTeamNotFound
+		}
+		return team, err
+	}
+	return team, nil
+}
+
+func (s *TeamService) buildTeamForm(ctx context.Context, teamID int64, last int, asOf *time.Time) (*contract.TeamForm, error) {
+	if last == 0 {
+		last = 5
+	}
+
+	matches, err := s.matchRepo.GetCompletedByTeam(ctx, teamID, time.Now().Format("2006-01-02"), asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	form := computeTeamForm(teamID, matches, last)
+	return &form, nil
+}
+
+type streakTracker struct {
+	current contract.TeamStreak
+	record  contract.TeamStreak
+}
+
+func (t *streakTracker) next(extends bool, date string) {
+	if !extends {
+		t.current = contract.TeamStreak{}
+		return
+	}
+	if t.current.Count == 0 {
+		t.current.From = date
+	}
+	t.current.Count++
+	t.current.To = date
+	if t.current.Count > t.record.Count {
+		t.record = t.current
+	}
+}
+
+// computeTeamForm expects matches ordered from oldest to newest, as returned
+// by MatchRepository.GetCompletedByTeam.
+func computeTeamForm(teamID int64, matches []entity.MatchWinStat, last int) contract.TeamForm {
+	var unbeaten, winning, losing, scoreless streakTracker
+	results := make([]byte, 0, len(matches))
+
+	for _, m := range matches {
+		if m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		goalsFor, goalsAgainst := *m.HomeScore, *m.AwayScore
+		if m.AwayTeamID == teamID {
+			goalsFor, goalsAgainst = goalsAgainst, goalsFor
+		}
+
+		result := byte('D')
+		if goalsFor > goalsAgainst {
+			result = 'W'
+		} else if goalsFor < goalsAgainst {
+			result = 'L'
+		}
+		results = append(results, result)
+
+		date := m.MatchDate.Format("2006-01-02")
+		unbeaten.next(result != 'L', date)
+		winning.next(result == 'W', date)
+		losing.next(result == 'L', date)
+		scoreless.next(goalsFor == 0, date)
+	}
+
+	recent := results
+	if len(recent) > last {
+		recent = recent[len(recent)-last:]
+	}
+
+	return contract.TeamForm{
+		Form:   string(recent),
+		Played: len(results),
+		Current: contract.TeamStreaks{
+			Unbeaten:  unbeaten.current,
+			Winning:   winning.current,
+			Losing:    losing.current,
+			Scoreless: scoreless.current,
+		},
+		Record: contract.TeamStreaks{
+			Unbeaten:  unbeaten.record,
+			Winning:   winning.record,
+			Losing:    losing.record,
+			Scoreless: scoreless.record,
+		},
+	}
+}
+
+func headToHeadMatchToResponse(m *entity.Match, teamID int64) contract.HeadToHeadMatch {
+	resp := contract.HeadToHeadMatch{
+		MatchID:   m.ID,
+		MatchDate: m.MatchDate.Format("2006-01-02"),
+		MatchTime: m.MatchTime,
+		HomeTeam:  m.HomeTeamID,
+		AwayTeam:  m.AwayTeamID,
+	}
+	if m.HomeScore == nil || m.AwayScore == nil {
+		return resp
+	}
+
+	resp.HomeScore = *m.HomeScore
+	resp.AwayScore = *m.AwayScore
+
+	goalsFor, goalsAgainst := resp.HomeScore, resp.AwayScore
+	if m.AwayTeamID == teamID {
+		goalsFor, goalsAgainst = goalsAgainst, goalsFor
+	}
+	switch {
+	case goalsFor > goalsAgainst:
+		resp.Result = "W"
+	case goalsFor < goalsAgainst:
+		resp.Result = "L"
+	default:
+		resp.Result = "D"
+	}
+	return resp
+}
+
+func teamStatMatchToResponse(m entity.TeamStatMatch) *contract.TeamStatMatch {
+	if m.MatchID == nil {
+		return nil
+	}
+	resp := &contract.TeamStatMatch{
+		MatchID: *m.MatchID,
+	}
+	if m.MatchDate != nil {
+		resp.MatchDate = m.MatchDate.Format("2006-01-02")
+	}
+	if m.OpponentID != nil {
+		resp.Opponent.ID = *m.OpponentID
+	}
+	if m.OpponentName != nil {
+		resp.Opponent.Name = *m.OpponentName
+	}
+	if m.OpponentLogo != nil {
+		resp.Opponent.Logo = *m.OpponentLogo
+	}
+	if m.GoalsFor != nil {
+		resp.GoalsFor = *m.GoalsFor
+	}
+	if m.GoalsAgainst != nil {
+		resp.GoalsAgainst = *m.GoalsAgainst
+	}
+	return resp
+}
+
+func (s *TeamService) checkVenueExists(ctx context.Context, venueID int64) error {
+	if _, err := s.venueRepo.Get(ctx, venueID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrVenueNotFound
+		}
+		return err
+	}
+	return nil
+}
+
 func teamToResponse(t *entity.Team) *contract.TeamResponse {
 	return &contract.TeamResponse{
 		ID:          t.ID,
@@ -137,6 +525,7 @@ func teamToResponse(t *entity.Team) *contract.TeamResponse {
 		YearFounded: t.YearFounded,
 		Address:     t.Address,
 		City:        t.City,
+		HomeVenueID: t.HomeVenueID,
 		CreatedAt:   t.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:   t.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
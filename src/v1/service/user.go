@@ -0,0 +1,501 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-test/lib/atomic"
+	"go-test/lib/logger"
+	"go-test/lib/provider"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+	"time"
+)
+
+type UserConfig struct {
+	InvitationTTL time.Duration
+}
+
+type UserService struct {
+	userRepo       UserRepository
+	userTeamRepo   UserTeamRepository
+	teamRepo       TeamRepository
+	invitationRepo InvitationRepository
+	sessionRepo    SessionRepository
+	feedTokenRepo  FeedTokenRepository
+	revocations    RevocationStore
+	unlocker       AccountUnlocker
+	tokenProvider  provider.TokenProvider
+	atomicSession  atomic.AtomicSessionProvider
+	cfg            UserConfig
+}
+
+func NewUserService(
+	userRepo UserRepository,
+	userTeamRepo UserTeamRepository,
+	teamRepo TeamRepository,
+	invitationRepo InvitationRepository,
+	sessionRepo SessionRepository,
+	feedTokenRepo FeedTokenRepository,
+	revocations RevocationStore,
+	unlocker AccountUnlocker,
+	tokenProvider provider.TokenProvider,
+	atomicSession atomic.AtomicSessionProvider,
+	cfg UserConfig,
+) *UserService {
+	return &UserService{
+		userRepo:       userRepo,
+		userTeamRepo:   userTeamRepo,
+		teamRepo:       teamRepo,
+		invitationRepo: invitationRepo,
+		sessionRepo:    sessionRepo,
+		feedTokenRepo:  feedTokenRepo,
+		revocations:    revocations,
+		unlocker:       unlocker,
+		tokenProvider:  tokenProvider,
+		atomicSession:  atomicSession,
+		cfg:            cfg,
+	}
+}
+
+func (s *UserService) GetAllUsers(ctx context.Context) ([]contract.UserResponse, error) {
+	users, err := s.userRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.UserResponse, 0, len(users))
+	for _, u := range users {
+		resp, err := s.userToResponse(ctx, &u)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, *resp)
+	}
+
+	return response, nil
+}
+
+func (s *UserService) GetUser(ctx context.Context, id int64) (*contract.UserResponse, error) {
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userToResponse(ctx, &user)
+}
+
+// ChangeRole sets the role of a user and replaces their team grants. Both are
+// carried in the user's tokens, so a change signs the user out everywhere.
+func (s *UserService) ChangeRole(ctx context.Context, actorID, id int64, req contract.ChangeUserRoleRequest) (*contract.UserResponse, error) {
+	if actorID == id {
+		return nil, apperrors.ErrUserSelfModification
+	}
+
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	role := entity.UserRole(req.Role)
+	if err := s.checkTeamGrants(ctx, role, req.TeamIDs); err != nil {
+		return nil, err
+	}
+
+	teamIDs, err := s.userTeamRepo.GetTeamIDsByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	changed := user.Role != role || !sameTeamIDs(teamIDs, req.TeamIDs)
+
+	user.Role = role
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.userTeamRepo.DeleteByUser(ctx, user.ID); err != nil {
+			return err
+		}
+		for _, teamID := range req.TeamIDs {
+			if _, err := s.userTeamRepo.Create(ctx, &entity.UserTeam{UserID: user.ID, TeamID: teamID}); err != nil {
+				return err
+			}
+		}
+		if err := s.userRepo.Update(ctx, &user); err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+		return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, user.ID)
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("ChangeRole err: ", err)
+		return nil, err
+	}
+
+	return s.userToResponse(ctx, &user)
+}
+
+// DisableUser blocks the user from logging in and signs them out everywhere.
+// The account and its grants are kept so it can be enabled again.
+func (s *UserService) DisableUser(ctx context.Context, actorID, id int64) (*contract.UserResponse, error) {
+	return s.setDisabled(ctx, actorID, id, true)
+}
+
+func (s *UserService) EnableUser(ctx context.Context, actorID, id int64) (*contract.UserResponse, error) {
+	return s.setDisabled(ctx, actorID, id, false)
+}
+
+func (s *UserService) DeleteUser(ctx context.Context, actorID, id int64) error {
+	if actorID == id {
+		return apperrors.ErrUserSelfModification
+	}
+
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.userRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := s.userTeamRepo.DeleteByUser(ctx, id); err != nil {
+			return err
+		}
+		return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, id)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrUserNotFound
+	}
+	return err
+}
+
+// RevokeSessions signs the user out of every session, e.g. when a staff
+// member leaves. Unlike DisableUser it does not stop them logging in again.
+func (s *UserService) RevokeSessions(ctx context.Context, id int64) error {
+	if _, err := s.getUser(ctx, id); err != nil {
+		return err
+	}
+
+	return atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, id)
+	})
+}
+
+// UnlockUser lifts the login lockout of the user after too many failed
+// logins, e.g. once they confirmed it was them.
+func (s *UserService) UnlockUser(ctx context.Context, id int64) error {
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.unlocker.Unlock(ctx, user.Email)
+}
+
+// CreateInvitation invites a new user with the given role and teams. The
+// token is only returned here; the invitee exchanges it for an account
+// through AuthService.AcceptInvitation.
+func (s *UserService) CreateInvitation(ctx context.Context, actorID int64, req contract.CreateInvitationRequest) (*contract.InvitationResponse, error) {
+	_, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err == nil {
+		return nil, apperrors.ErrEmailAlreadyExists
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	role := entity.UserRole(req.Role)
+	if err := s.checkTeamGrants(ctx, role, req.TeamIDs); err != nil {
+		return nil, err
+	}
+
+	token, err := s.tokenProvider.NewToken()
+	if err != nil {
+		logger.GetLogger(ctx).Error("NewToken err: ", err)
+		return nil, err
+	}
+
+	invitation := &entity.Invitation{
+		Email:     req.Email,
+		Role:      role,
+		TeamIDs:   req.TeamIDs,
+		TokenHash: provider.HashToken(token),
+		InvitedBy: actorID,
+		ExpiresAt: time.Now().Add(s.cfg.InvitationTTL),
+	}
+	if invitation.TeamIDs == nil {
+		invitation.TeamIDs = []int64{}
+	}
+
+	var invitationID int64
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		id, err := s.invitationRepo.Create(ctx, invitation)
+		if err != nil {
+			return err
+		}
+		invitationID = id
+		return nil
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("CreateInvitation err: ", err)
+		return nil, err
+	}
+
+	invitation.ID = invitationID
+	invitation.CreatedAt = time.Now()
+
+	response := invitationToResponse(invitation)
+	response.Token = token
+	return response, nil
+}
+
+func (s *UserService) GetInvitations(ctx context.Context) ([]contract.InvitationResponse, error) {
+	invitations, err := s.invitationRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.InvitationResponse, 0, len(invitations))
+	for _, inv := range invitations {
+		response = append(response, *invitationToResponse(&inv))
+	}
+
+	return response, nil
+}
+
+// DeleteInvitation revokes an invitation; its token can no longer be accepted.
+func (s *UserService) DeleteInvitation(ctx context.Context, id int64) error {
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.invitationRepo.Delete(ctx, id)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrInvitationNotFound
+	}
+	return err
+}
+
+// SetRoleByEmail changes the role of a user. Self-registered accounts are
+// viewers, so this is how the first super admin is created. Team grants are
+// dropped when the new role is not team-scoped. A changed role signs the user
+// out everywhere.
+func (s *UserService) SetRoleByEmail(ctx context.Context, email string, role entity.UserRole) error {
+	if !role.IsValid() {
+		return apperrors.ErrValidationFailed
+	}
+
+	user, err := s.getUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	changed := user.Role != role
+
+	user.Role = role
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if !role.IsTeamScoped() {
+			if err := s.userTeamRepo.DeleteByUser(ctx, user.ID); err != nil {
+				return err
+			}
+		}
+		if err := s.userRepo.Update(ctx, &user); err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+		return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, user.ID)
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("SetRoleByEmail err: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// GrantTeam lets a team-scoped user manage a team. The grant is carried in the
+// token, so the user is signed out everywhere and picks it up on next login.
+func (s *UserService) GrantTeam(ctx context.Context, email string, teamID int64) error {
+	user, err := s.getUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if !user.Role.IsTeamScoped() {
+		return apperrors.ErrValidationFailed
+	}
+
+	if _, err := s.teamRepo.Get(ctx, teamID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrTeamNotFound
+		}
+		return err
+	}
+
+	return atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if _, err := s.userTeamRepo.Create(ctx, &entity.UserTeam{UserID: user.ID, TeamID: teamID}); err != nil {
+			return err
+		}
+		return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, user.ID)
+	})
+}
+
+// RevokeTeam takes a team away from a team-scoped user and, like GrantTeam,
+// signs them out everywhere.
+func (s *UserService) RevokeTeam(ctx context.Context, email string, teamID int64) error {
+	user, err := s.getUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.userTeamRepo.Delete(ctx, user.ID, teamID); err != nil {
+			return err
+		}
+		return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, user.ID)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrTeamNotFound
+	}
+	return err
+}
+
+func (s *UserService) setDisabled(ctx context.Context, actorID, id int64, disabled bool) (*contract.UserResponse, error) {
+	if actorID == id {
+		return nil, apperrors.ErrUserSelfModification
+	}
+
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	user.DisabledAt = nil
+	if disabled {
+		now := time.Now()
+		user.DisabledAt = &now
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.userRepo.Update(ctx, &user); err != nil {
+			return err
+		}
+		if disabled {
+			return revokeUserSessions(ctx, s.sessionRepo, s.feedTokenRepo, s.revocations, user.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("setDisabled err: ", err)
+		return nil, err
+	}
+
+	return s.userToResponse(ctx, &user)
+}
+
+// checkTeamGrants validates the teams granted with a role. Only team-scoped
+// roles take teams, and every team must exist.
+func (s *UserService) checkTeamGrants(ctx context.Context, role entity.UserRole, teamIDs []int64) error {
+	if len(teamIDs) > 0 && !role.IsTeamScoped() {
+		return apperrors.ErrValidationFailed
+	}
+
+	for _, teamID := range teamIDs {
+		if _, err := s.teamRepo.Get(ctx, teamID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return apperrors.ErrTeamNotFound
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *UserService) userToResponse(ctx context.Context, u *entity.User) (*contract.UserResponse, error) {
+	teamIDs := []int64{}
+	if u.Role.IsTeamScoped() {
+		ids, err := s.userTeamRepo.GetTeamIDsByUser(ctx, u.ID)
+		if err != nil {
+			return nil, err
+		}
+		if ids != nil {
+			teamIDs = ids
+		}
+	}
+
+	var disabledAt *string
+	if u.DisabledAt != nil {
+		formatted := u.DisabledAt.Format("2006-01-02 15:04:05")
+		disabledAt = &formatted
+	}
+
+	return &contract.UserResponse{
+		ID:         u.ID,
+		Name:       u.Name,
+		Email:      u.Email,
+		Role:       string(u.Role),
+		TeamIDs:    teamIDs,
+		Disabled:   u.DisabledAt != nil,
+		DisabledAt: disabledAt,
+		CreatedAt:  u.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:  u.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+func invitationToResponse(inv *entity.Invitation) *contract.InvitationResponse {
+	var acceptedAt *string
+	if inv.AcceptedAt != nil {
+		formatted := inv.AcceptedAt.Format("2006-01-02 15:04:05")
+		acceptedAt = &formatted
+	}
+
+	teamIDs := []int64(inv.TeamIDs)
+	if teamIDs == nil {
+		teamIDs = []int64{}
+	}
+
+	return &contract.InvitationResponse{
+		ID:         inv.ID,
+		Email:      inv.Email,
+		Role:       string(inv.Role),
+		TeamIDs:    teamIDs,
+		InvitedBy:  inv.InvitedBy,
+		ExpiresAt:  inv.ExpiresAt.Format("2006-01-02 15:04:05"),
+		AcceptedAt: acceptedAt,
+		CreatedAt:  inv.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *UserService) getUser(ctx context.Context, id int64) (entity.User, error) {
+	user, err := s.userRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.User{}, apperrors.ErrUserNotFound
+		}
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
+func (s *UserService) getUserByEmail(ctx context.Context, email string) (entity.User, error) {
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.User{}, apperrors.ErrUserNotFound
+		}
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
+// sameTeamIDs reports whether a and b hold the same teams in any order.
+func sameTeamIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[int64]bool, len(a))
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if !seen[id] {
+			return false
+		}
+	}
+	return true
+}
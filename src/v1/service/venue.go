@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-test/lib/atomic"
+	"go-test/lib/logger"
+	"go-test/src/entity"
+	apperrors "go-test/src/errors"
+	"go-test/src/v1/contract"
+	"sort"
+	"time"
+)
+
+type VenueConfig struct {
+	DefaultTimezone string
+}
+
+const (
+	venueCalendarDefaultDays = 90
+	venueCalendarMaxDays     = 366
+)
+
+type VenueService struct {
+	venueRepo      VenueRepository
+	venueBlockRepo VenueBlockRepository
+	teamRepo       TeamRepository
+	matchRepo      MatchRepository
+	atomicSession  atomic.AtomicSessionProvider
+	cfg            VenueConfig
+}
+
+func NewVenueService(
+	venueRepo VenueRepository,
+	venueBlockRepo VenueBlockRepository,
+	teamRepo TeamRepository,
+	matchRepo MatchRepository,
+	atomicSession atomic.AtomicSessionProvider,
+	cfg VenueConfig,
+) *VenueService {
+	return &VenueService{
+		venueRepo:      venueRepo,
+		venueBlockRepo: venueBlockRepo,
+		teamRepo:       teamRepo,
+		matchRepo:      matchRepo,
+		atomicSession:  atomicSession,
+		cfg:            cfg,
+	}
+}
+
+func (s *VenueService) CreateVenue(ctx context.Context, req contract.CreateVenueRequest) (*contract.VenueResponse, error) {
+	venue := &entity.Venue{
+		Name:      req.Name,
+		City:      req.City,
+		Capacity:  req.Capacity,
+		Surface:   entity.VenueSurfaceGrass,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Timezone:  s.cfg.DefaultTimezone,
+	}
+	if req.Surface != "" {
+		venue.Surface = entity.VenueSurface(req.Surface)
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return nil, apperrors.ErrInvalidTimezone
+		}
+		venue.Timezone = req.Timezone
+	}
+
+	var venueID int64
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		id, err := s.venueRepo.Create(ctx, venue)
+		if err != nil {
+			return err
+		}
+		venueID = id
+		return nil
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("CreateVenue err: ", err)
+		return nil, err
+	}
+
+	venue.ID = venueID
+	return venueToResponse(venue), nil
+}
+
+func (s *VenueService) GetVenue(ctx context.Context, id int64) (*contract.VenueResponse, error) {
+	venue, err := s.venueRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrVenueNotFound
+		}
+		return nil, err
+	}
+
+	return venueToResponse(&venue), nil
+}
+
+func (s *VenueService) GetAllVenues(ctx context.Context) ([]contract.VenueResponse, error) {
+	venues, err := s.venueRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]contract.VenueResponse, 0, len(venues))
+	for _, v := range venues {
+		response = append(response, *venueToResponse(&v))
+	}
+
+	return response, nil
+}
+
+func (s *VenueService) UpdateVenue(ctx context.Context, id int64, req contract.UpdateVenueRequest) (*contract.VenueResponse, error) {
+	venue, err := s.venueRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrVenueNotFound
+		}
+		return nil, err
+	}
+
+	if req.Name != "" {
+		venue.Name = req.Name
+	}
+	if req.City != "" {
+		venue.City = req.City
+	}
+	if req.Capacity > 0 {
+		venue.Capacity = req.Capacity
+	}
+	if req.Surface != "" {
+		venue.Surface = entity.VenueSurface(req.Surface)
+	}
+	if req.Latitude != nil && req.Longitude != nil {
+		venue.Latitude = req.Latitude
+		venue.Longitude = req.Longitude
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return nil, apperrors.ErrInvalidTimezone
+		}
+		venue.Timezone = req.Timezone
+	}
+
+	err = atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.venueRepo.Update(ctx, &venue)
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("UpdateVenue err: ", err)
+		return nil, err
+	}
+
+	return venueToResponse(&venue), nil
+}
+
+// DeleteVenue soft deletes a venue and unsets it as home venue. Matches keep
+// their venue so past fixtures still show where they were played.
+func (s *VenueService) DeleteVenue(ctx context.Context, id int64) error {
+	_, err := s.venueRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrVenueNotFound
+		}
+		return err
+	}
+
+	return atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		if err := s.teamRepo.ClearHomeVenue(ctx, id); err != nil {
+			return err
+		}
+		return s.venueRepo.Delete(ctx, id)
+	})
+}
+
+// CreateVenueBlock blocks a venue for a date range. Matches already scheduled
+// in the range are kept and reported so they can be moved.
+func (s *VenueService) CreateVenueBlock(ctx context.Context, venueID int64, req contract.CreateVenueBlockRequest) (*contract.VenueBlockResponse, error) {
+	if _, err := s.venueRepo.Get(ctx, venueID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrVenueNotFound
+		}
+		return nil, err
+	}
+
+	block := &entity.VenueBlock{
+		VenueID:   venueID,
+		StartDate: parseDate(req.StartDate),
+		EndDate:   parseDate(req.EndDate),
+		Reason:    entity.VenueBlockReason(req.Reason),
+		Note:      req.Note,
+	}
+	if block.StartDate.After(block.EndDate) {
+		return nil, apperrors.ErrInvalidDateRange
+	}
+
+	var blockID int64
+	err := atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		id, err := s.venueBlockRepo.Create(ctx, block)
+		if err != nil {
+			return err
+		}
+		blockID = id
+		return nil
+	})
+
+	if err != nil {
+		logger.GetLogger(ctx).Error("CreateVenueBlock err: ", err)
+		return nil, err
+	}
+
+	block.ID = blockID
+
+	matches, err := s.matchRepo.GetVenueFixturesInRange(ctx, venueID, block.StartDate, block.EndDate, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	response := venueBlockToResponse(block)
+	for _, m := range matches {
+		response.ConflictingMatchIDs = append(response.ConflictingMatchIDs, m.ID)
+	}
+
+	return response, nil
+}
+
+func (s *VenueService) DeleteVenueBlock(ctx context.Context, venueID, blockID int64) error {
+	block, err := s.venueBlockRepo.Get(ctx, blockID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrVenueBlockNotFound
+		}
+		return err
+	}
+	if block.VenueID != venueID {
+		return apperrors.ErrVenueBlockNotFound
+	}
+
+	return atomic.Atomic(ctx, s.atomicSession, func(ctx context.Context) error {
+		return s.venueBlockRepo.Delete(ctx, blockID)
+	})
+}
+
+// GetVenueCalendar lists the matches and blocks of a venue in a date range,
+// ordered by start date.
+func (s *VenueService) GetVenueCalendar(ctx context.Context, venueID int64, query contract.VenueCalendarQuery) (*contract.VenueCalendarResponse, error) {
+	venue, err := s.venueRepo.Get(ctx, venueID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrVenueNotFound
+		}
+		return nil, err
+	}
+
+	fromDate, toDate, err := parseDateRange(query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to time.Time
+	switch {
+	case fromDate != nil:
+		from = *fromDate
+	case toDate != nil:
+		from = toDate.AddDate(0, 0, -venueCalendarDefaultDays)
+	default:
+		now := time.Now().In(loadLocation(venue.Timezone))
+		from = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	if toDate != nil {
+		to = *toDate
+	} else {
+		to = from.AddDate(0, 0, venueCalendarDefaultDays)
+	}
+	if daysBetween(from, to) > venueCalendarMaxDays {
+		return nil, apperrors.ErrInvalidDateRange
+	}
+
+	blocks, err := s.venueBlockRepo.GetByVenueInRange(ctx, venueID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.GetVenueFixturesInRange(ctx, venueID, from, to, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make(map[int64]contract.TeamBrief)
+	teamBrief := func(id int64) (contract.TeamBrief, error) {
+		if brief, ok := teams[id]; ok {
+			return brief, nil
+		}
+		team, err := s.teamRepo.Get(ctx, id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return contract.TeamBrief{}, err
+		}
+		teams[id] = contract.TeamBrief{ID: id, Name: team.Name, Logo: team.Logo}
+		return teams[id], nil
+	}
+
+	entries := make([]contract.VenueCalendarEntry, 0, len(blocks)+len(matches))
+	for i := range blocks {
+		entries = append(entries, contract.VenueCalendarEntry{
+			Type:      "blocked",
+			StartDate: blocks[i].StartDate.Format("2006-01-02"),
+			EndDate:   blocks[i].EndDate.Format("2006-01-02"),
+			Block:     venueBlockToResponse(&blocks[i]),
+		})
+	}
+	for _, m := range matches {
+		homeTeam, err := teamBrief(m.HomeTeamID)
+		if err != nil {
+			return nil, err
+		}
+		awayTeam, err := teamBrief(m.AwayTeamID)
+		if err != nil {
+			return nil, err
+		}
+		date := m.MatchDate.Format("2006-01-02")
+		entries = append(entries, contract.VenueCalendarEntry{
+			Type:      "match",
+			StartDate: date,
+			EndDate:   date,
+			Match: &contract.VenueCalendarMatch{
+				ID:        m.ID,
+				HomeTeam:  homeTeam,
+				AwayTeam:  awayTeam,
+				KickoffAt: m.KickoffAt.In(loadLocation(m.Timezone)),
+				Timezone:  m.Timezone,
+				Status:    string(m.Status),
+			},
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].StartDate < entries[j].StartDate
+	})
+
+	return &contract.VenueCalendarResponse{
+		Venue:   *venueToBrief(&venue),
+		From:    from.Format("2006-01-02"),
+		To:      to.Format("2006-01-02"),
+		Entries: entries,
+	}, nil
+}
+
+func venueBlockToResponse(b *entity.VenueBlock) *contract.VenueBlockResponse {
+	return &contract.VenueBlockResponse{
+		ID:        b.ID,
+		VenueID:   b.VenueID,
+		StartDate: b.StartDate.Format("2006-01-02"),
+		EndDate:   b.EndDate.Format("2006-01-02"),
+		Reason:    string(b.Reason),
+		Note:      b.Note,
+		CreatedAt: b.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func venueToResponse(v *entity.Venue) *contract.VenueResponse {
+	return &contract.VenueResponse{
+		ID:        v.ID,
+		Name:      v.Name,
+		City:      v.City,
+		Capacity:  v.Capacity,
+		Surface:   string(v.Surface),
+		Latitude:  v.Latitude,
+		Longitude: v.Longitude,
+		Timezone:  v.Timezone,
+		CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: v.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func venueToBrief(v *entity.Venue) *contract.VenueBrief {
+	if v == nil {
+		return nil
+	}
+	return &contract.VenueBrief{
+		ID:   v.ID,
+		Name: v.Name,
+		City: v.City,
+	}
+}
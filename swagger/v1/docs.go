@@ -15,131 +15,41 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/auth/login": {
-            "post": {
-                "description": "Login with email and password",
-                "consumes": [
-                    "application/json"
-                ],
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Public keys access tokens are verified with, selected by the token's kid header. Returned as a plain JWKS document without the response envelope",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "auth"
                 ],
-                "summary": "Login user",
-                "parameters": [
-                    {
-                        "description": "login request",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/go-test_src_v1_contract.LoginRequest"
-                        }
-                    }
-                ],
+                "summary": "JSON Web Key Set",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.AuthResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/auth/register": {
-            "post": {
-                "description": "Register a new user account",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Register user",
-                "parameters": [
-                    {
-                        "description": "register request",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/go-test_src_v1_contract.RegisterRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.AuthResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                            "$ref": "#/definitions/go-test_src_v1_contract.JWKSResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/matches": {
+        "/v1/api-keys": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get list of all matches",
+                "description": "Get list of all API keys that have not been revoked, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "matches"
+                    "api-keys"
                 ],
-                "summary": "Get all matches",
+                "summary": "Get all API keys",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -154,7 +64,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
+                                                "$ref": "#/definitions/go-test_src_v1_contract.APIKeyResponse"
                                             }
                                         }
                                     }
@@ -162,8 +72,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
@@ -176,7 +86,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new match schedule between two teams",
+                "description": "Create a key for a machine client, sent in the X-API-Key header instead of a JWT. The key is only returned in this response",
                 "consumes": [
                     "application/json"
                 ],
@@ -184,17 +94,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "matches"
+                    "api-keys"
                 ],
-                "summary": "Create match",
+                "summary": "Create API key",
                 "parameters": [
                     {
-                        "description": "create match request",
+                        "description": "create API key request",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/go-test_src_v1_contract.CreateMatchRequest"
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreateAPIKeyRequest"
                         }
                     }
                 ],
@@ -210,7 +120,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.APIKeyResponse"
                                         }
                                     }
                                 }
@@ -222,35 +132,29 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
-                        }
                     }
                 }
             }
         },
-        "/v1/matches/{id}": {
-            "get": {
+        "/v1/api-keys/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a match by its ID including goals",
+                "description": "Revoke an API key so it can no longer be used",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "matches"
+                    "api-keys"
                 ],
-                "summary": "Get match by ID",
+                "summary": "Revoke API key",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "match ID",
+                        "description": "API key ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -260,19 +164,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
                     "404": {
@@ -282,14 +174,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Update a match schedule by ID (only scheduled matches can be updated)",
+            }
+        },
+        "/v1/auth/email/resend": {
+            "post": {
+                "description": "Mail a new verification link to an unverified account. Always succeeds so it does not reveal whether the email is registered. One email can request a link once per cooldown; a 429 response carries a Retry-After header",
                 "consumes": [
                     "application/json"
                 ],
@@ -297,24 +186,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "matches"
+                    "auth"
                 ],
-                "summary": "Update match",
+                "summary": "Resend verification email",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "match ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "update match request",
+                        "description": "resend verification request",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/go-test_src_v1_contract.UpdateMatchRequest"
+                            "$ref": "#/definitions/go-test_src_v1_contract.ResendVerificationRequest"
                         }
                     }
                 ],
@@ -322,19 +204,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
                     "400": {
@@ -343,35 +213,37 @@ const docTemplate = `{
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+            }
+        },
+        "/v1/auth/email/verify": {
+            "post": {
+                "description": "Verify the email of a registered account with the token from a verification link",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Soft delete a match and its goals by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "matches"
+                    "auth"
                 ],
-                "summary": "Delete match",
+                "summary": "Verify email",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "match ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "verify email request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.VerifyEmailRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -381,8 +253,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
@@ -390,33 +262,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/matches/{id}/report": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/v1/auth/invitations/accept": {
+            "post": {
+                "description": "Create an account from an invitation token and log in. The role and teams come from the invitation",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Get detailed match report including top scorer and team win statistics",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "matches"
+                    "auth"
                 ],
-                "summary": "Get match report",
+                "summary": "Accept invitation",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "match ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "accept invitation request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.AcceptInvitationRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -426,7 +298,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchReportResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.AuthResponse"
                                         }
                                     }
                                 }
@@ -439,8 +311,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
@@ -448,14 +320,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/matches/{id}/result": {
+        "/v1/auth/login": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Submit final score and goals for a match",
+                "description": "Login with email and password. Failed logins make the next attempt wait longer and too many lock the account or the client IP for a while; a 429 response carries a Retry-After header",
                 "consumes": [
                     "application/json"
                 ],
@@ -463,24 +330,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "matches"
+                    "auth"
                 ],
-                "summary": "Submit match result",
+                "summary": "Login user",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "match ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "submit result request",
+                        "description": "login request",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/go-test_src_v1_contract.SubmitResultRequest"
+                            "$ref": "#/definitions/go-test_src_v1_contract.LoginRequest"
                         }
                     }
                 ],
@@ -496,21 +356,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.AuthResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
@@ -518,21 +384,147 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/players": {
-            "get": {
+        "/v1/auth/logout": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get list of all players",
+                "description": "Revoke the current session and its access token",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "auth"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/password/forgot": {
+            "post": {
+                "description": "Mail a single-use password reset link in the caller's language. Always succeeds so it does not reveal whether the email is registered. One email can request a link once per cooldown; a 429 response carries a Retry-After header",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Forgot password",
+                "parameters": [
+                    {
+                        "description": "forgot password request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/password/reset": {
+            "post": {
+                "description": "Set a new password with the token from a reset link. Signs the user out of every session",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "description": "reset password request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/refresh": {
+            "post": {
+                "description": "Exchange a refresh token for a new access token and refresh token. A refresh token can only be used once; reusing one revokes its session",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "description": "refresh request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.RefreshRequest"
+                        }
+                    }
                 ],
-                "summary": "Get all players",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -545,31 +537,31 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
-                                            }
+                                            "$ref": "#/definitions/go-test_src_v1_contract.AuthResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/auth/register": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new player and assign to a team",
+                "description": "Register a new viewer account and mail it an email verification link. The account can log in once verified. Only available when AUTH_OPEN_REGISTRATION is enabled",
                 "consumes": [
                     "application/json"
                 ],
@@ -577,17 +569,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "auth"
                 ],
-                "summary": "Create player",
+                "summary": "Register user",
                 "parameters": [
                     {
-                        "description": "create player request",
+                        "description": "register request",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/go-test_src_v1_contract.CreatePlayerRequest"
+                            "$ref": "#/definitions/go-test_src_v1_contract.RegisterRequest"
                         }
                     }
                 ],
@@ -603,7 +595,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.RegisterResponse"
                                         }
                                     }
                                 }
@@ -616,8 +608,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
@@ -625,30 +623,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/players/{id}": {
+        "/v1/invitations": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a player by their ID",
+                "description": "Get list of all invitations, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
-                ],
-                "summary": "Get player by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "player ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "invitations"
                 ],
+                "summary": "Get all invitations",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -661,28 +650,31 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.InvitationResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a player by ID",
+                "description": "Invite a user with a role and, for team managers, the teams they manage. The token is only returned in this response",
                 "consumes": [
                     "application/json"
                 ],
@@ -690,30 +682,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "invitations"
                 ],
-                "summary": "Update player",
+                "summary": "Create invitation",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "player ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "update player request",
+                        "description": "create invitation request",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/go-test_src_v1_contract.UpdatePlayerRequest"
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreateInvitationRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -723,7 +708,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.InvitationResponse"
                                         }
                                     }
                                 }
@@ -736,32 +721,34 @@ const docTemplate = `{
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/invitations/{id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a player by ID",
+                "description": "Revoke an invitation so its token can no longer be accepted",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "invitations"
                 ],
-                "summary": "Delete player",
+                "summary": "Revoke invitation",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "player ID",
+                        "description": "invitation ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -783,21 +770,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/teams": {
+        "/v1/matches": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get list of all football teams",
+                "description": "Get list of all matches",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "matches"
+                ],
+                "summary": "Get all matches",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IANA timezone to render kickoff_at in (or X-Timezone header)",
+                        "name": "tz",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all teams",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -812,7 +807,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                                "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
                                             }
                                         }
                                     }
@@ -834,50 +829,38 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new football team",
+                "description": "Create a new match schedule between two teams",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "matches"
                 ],
-                "summary": "Create team",
+                "summary": "Create match",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "team name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
+                        "description": "create match request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreateMatchRequest"
+                        }
                     },
                     {
-                        "type": "file",
-                        "description": "logo image",
-                        "name": "logo",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "year founded (1800-2100)",
-                        "name": "year_founded",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "team address",
-                        "name": "address",
-                        "in": "formData"
+                        "type": "boolean",
+                        "description": "admin only: save despite scheduling conflicts",
+                        "name": "force",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "team city",
-                        "name": "city",
-                        "in": "formData",
-                        "required": true
+                        "description": "IANA timezone to render kickoff_at in (or X-Timezone header)",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -892,7 +875,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
                                         }
                                     }
                                 }
@@ -904,32 +887,97 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
                     }
                 }
             }
         },
-        "/v1/teams/{id}": {
+        "/v1/matches/fixtures.ics": {
+            "get": {
+                "description": "iCalendar feed of every match, authenticated with a feed token",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "calendar"
+                ],
+                "summary": "All fixtures calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "feed token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/matches/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a football team by its ID",
+                "description": "Get a match by its ID including goals",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "matches"
                 ],
-                "summary": "Get team by ID",
+                "summary": "Get match by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "team ID",
+                        "description": "match ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone to render kickoff_at in (or X-Timezone header)",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -944,7 +992,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
                                         }
                                     }
                                 }
@@ -965,54 +1013,45 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a football team by ID",
+                "description": "Update a match schedule by ID (only scheduled matches can be updated)",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "matches"
                 ],
-                "summary": "Update team",
+                "summary": "Update match",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "team ID",
+                        "description": "match ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "team name",
-                        "name": "name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "logo image",
-                        "name": "logo",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "year founded (1800-2100)",
-                        "name": "year_founded",
-                        "in": "formData"
+                        "description": "update match request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.UpdateMatchRequest"
+                        }
                     },
                     {
-                        "type": "string",
-                        "description": "team address",
-                        "name": "address",
-                        "in": "formData"
+                        "type": "boolean",
+                        "description": "admin only: save despite scheduling conflicts",
+                        "name": "force",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "team city",
-                        "name": "city",
-                        "in": "formData"
+                        "description": "IANA timezone to render kickoff_at in (or X-Timezone header)",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1027,7 +1066,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
                                         }
                                     }
                                 }
@@ -1040,11 +1079,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
                     }
                 }
             },
@@ -1054,18 +1105,18 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a football team by ID",
+                "description": "Soft delete a match and its goals by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "matches"
                 ],
-                "summary": "Delete team",
+                "summary": "Delete match",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "team ID",
+                        "description": "match ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1087,25 +1138,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/teams/{id}/players": {
+        "/v1/matches/{id}/officials": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all players belonging to a specific team",
+                "description": "Get the officials assigned to a match, ordered by role",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "officials"
                 ],
-                "summary": "Get players by team",
+                "summary": "Get match officials",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "team ID",
+                        "description": "match ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1123,10 +1174,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
-                                            }
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchOfficialsResponse"
                                         }
                                     }
                                 }
@@ -1140,358 +1188,5487 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "go-test_lib_middleware_gin.Action": {
-            "type": "object",
-            "properties": {
-                "next_state": {
-                    "type": "string"
-                }
-            }
-        },
-        "go-test_lib_middleware_gin.Error": {
-            "type": "object",
-            "properties": {
-                "action": {
-                    "$ref": "#/definitions/go-test_lib_middleware_gin.Action"
-                },
-                "code": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
-                },
-                "message_severity": {
-                    "type": "string"
-                },
-                "message_title": {
-                    "type": "string"
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the officials of a match. A match has at most one referee, two assistant referees, one fourth official and one VAR. Officials from the home city of either team or over the weekly match limit are refused with 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "officials"
+                ],
+                "summary": "Assign match officials",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "assign match officials request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.AssignMatchOfficialsRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "admin only: save despite assignment conflicts",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchOfficialsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
                 }
             }
         },
-        "go-test_lib_middleware_gin.Meta": {
+        "/v1/matches/{id}/prediction": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get home/draw/away probabilities and the most likely scorelines for a scheduled match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Get match prediction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchPredictionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/matches/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get detailed match report including top scorer and team win statistics",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Get match report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone to render kickoff_at in (or X-Timezone header)",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchReportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/matches/{id}/result": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Submit final score and goals for a match",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Submit match result",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "submit result request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.SubmitResultRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone to render kickoff_at in (or X-Timezone header)",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.MatchResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/feed-token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Issue a new token for the iCalendar fixture feeds and revoke the previous one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calendar"
+                ],
+                "summary": "Rotate calendar feed token",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.FeedTokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the active sessions of the current user, most recently used first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get my sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.SessionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sign the current user out of one of their sessions, e.g. on another device",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Revoke my session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/officials": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of all officials ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "officials"
+                ],
+                "summary": "Get all officials",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.OfficialResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new match official (referee, assistant, fourth official or VAR)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "officials"
+                ],
+                "summary": "Create official",
+                "parameters": [
+                    {
+                        "description": "create official request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreateOfficialRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.OfficialResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/officials/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get an official by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "officials"
+                ],
+                "summary": "Get official by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "official ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.OfficialResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an official by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "officials"
+                ],
+                "summary": "Update official",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "official ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "update official request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.UpdateOfficialRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.OfficialResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a match official by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "officials"
+                ],
+                "summary": "Delete official",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "official ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/officials/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the matches of an official per role and the cards shown in the matches they refereed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "officials"
+                ],
+                "summary": "Get official report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "official ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.OfficialReportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/players": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of all players",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get all players",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new player and assign to a team",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Create player",
+                "parameters": [
+                    {
+                        "description": "create player request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreatePlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/players/leaderboard": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rank all players by goals or matches with a goal over an optional date range",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get player leaderboard",
+                "parameters": [
+                    {
+                        "enum": [
+                            "goals",
+                            "matches_with_goal"
+                        ],
+                        "type": "string",
+                        "description": "ranking metric",
+                        "name": "metric",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "as of the end of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of players (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerLeaderboardResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/players/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a player by their ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get player by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a player by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Update player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "update player request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.UpdatePlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a player by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Delete player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/players/{id}/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get goals and matches with a goal for a player, in total and broken down by team and season",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get player statistics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "as of the end of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.PlayerStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/rankings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all teams ordered by their current Elo rating, optionally as they stood at the end of a past date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rankings"
+                ],
+                "summary": "Get team rankings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "as of the end of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.RankingEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/simulations/season": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Monte Carlo simulation of the remaining scheduled matches, returning finishing position probabilities per team",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "simulations"
+                ],
+                "summary": "Simulate season",
+                "parameters": [
+                    {
+                        "description": "season simulation request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.SeasonSimulationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.SeasonSimulationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/staff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of all team staff ordered by team and role",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Get all staff",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.StaffResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a team staff member (head coach, assistant coach, goalkeeping coach or physio) with a tenure",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Create staff member",
+                "parameters": [
+                    {
+                        "description": "create staff request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreateStaffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.StaffResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/staff/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a team staff member by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Get staff member by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "staff ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.StaffResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a team staff member by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Update staff member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "staff ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "update staff request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.UpdateStaffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.StaffResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a team staff member by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Delete staff member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "staff ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/standings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the league table from all completed matches, optionally as it stood at the end of a past date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "standings"
+                ],
+                "summary": "Get standings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reproduce the table as of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.StandingEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/standings/what-if": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the league table as it would stand if the given scheduled matches ended with the given scores. Nothing is saved.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "standings"
+                ],
+                "summary": "What-if standings",
+                "parameters": [
+                    {
+                        "description": "hypothetical results",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.WhatIfStandingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.WhatIfStandingsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of all football teams",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get all teams",
+                "parameters": [
+                    {
+                        "enum": [
+                            "form"
+                        ],
+                        "type": "string",
+                        "description": "include extra data",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of matches in the form string (1-20, default 5)",
+                        "name": "form_last",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new football team",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Create team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "team name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "logo image",
+                        "name": "logo",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "year founded (1800-2100)",
+                        "name": "year_founded",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "team address",
+                        "name": "address",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "team city",
+                        "name": "city",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "home venue ID",
+                        "name": "home_venue_id",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a football team by its ID with the staff currently in post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "form"
+                        ],
+                        "type": "string",
+                        "description": "include extra data",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of matches in the form string (1-20, default 5)",
+                        "name": "form_last",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a football team by ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Update team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "team name",
+                        "name": "name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "logo image",
+                        "name": "logo",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "year founded (1800-2100)",
+                        "name": "year_founded",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "team address",
+                        "name": "address",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "team city",
+                        "name": "city",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "home venue ID",
+                        "name": "home_venue_id",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a football team by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Delete team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}/fixtures.ics": {
+            "get": {
+                "description": "iCalendar feed of a team's matches, authenticated with a feed token",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "calendar"
+                ],
+                "summary": "Team fixtures calendar feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "feed token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}/form": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the last-N results form string and current and record streaks for a team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team form",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of matches in the form string (1-20, default 5)",
+                        "name": "last",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "as of the end of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamFormResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}/head-to-head/{otherId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all completed meetings between two teams with aggregate record, last five results and top scorers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get head-to-head history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "opponent team ID",
+                        "name": "otherId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "as of the end of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.HeadToHeadResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}/players": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all players belonging to a specific team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get players by team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.PlayerResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}/rating-history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the Elo rating change of a team for every rated match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team rating history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamRatingHistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}/staff/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the past staff of a team whose tenure has ended, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team staff history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.StaffResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get home/away record, goals, clean sheets, biggest win and loss for a team from completed matches",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team statistics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reproduce the statistics as of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.TeamStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of all users with their role and managed teams",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.UserResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a user by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a user, drop their team grants and revoke their sessions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/disable": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block a user from logging in and revoke their sessions. The account is kept and can be enabled again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Disable user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/enable": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Allow a disabled user to log in again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Enable user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the role of a user and replace the teams they manage. Applies from the user's next token refresh",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Change user role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "change role request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.ChangeUserRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/sessions": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sign a user out of every session and revoke their access tokens. The user can still log in again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Revoke user sessions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lift the login lockout of a user locked after too many failed logins",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unlock user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/venues": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of all venues ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Get all venues",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/go-test_src_v1_contract.VenueResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new venue or stadium",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Create venue",
+                "parameters": [
+                    {
+                        "description": "create venue request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreateVenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.VenueResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/venues/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a venue by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Get venue by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.VenueResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a venue by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Update venue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "update venue request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.UpdateVenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.VenueResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a venue by ID and unset it as home venue of its teams",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Delete venue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/venues/{id}/blocks": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block a venue for a date range (maintenance, events, shared tenancy). Matches already scheduled in the range are returned in conflicting_match_ids",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Block venue dates",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "create venue block request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/go-test_src_v1_contract.CreateVenueBlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.VenueBlockResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/venues/{id}/blocks/{blockId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a venue block",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Unblock venue dates",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "venue block ID",
+                        "name": "blockId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/venues/{id}/calendar": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the matches and blocked dates of a venue, ordered by date. Defaults to the 90 days from today",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Get venue calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date (YYYY-MM-DD), at most 366 days after from",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/go-test_src_v1_contract.VenueCalendarResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/go-test_lib_middleware_gin.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "go-test_lib_middleware_gin.Action": {
+            "type": "object",
+            "properties": {
+                "next_state": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_lib_middleware_gin.Error": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/go-test_lib_middleware_gin.Action"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "details": {},
+                "message": {
+                    "type": "string"
+                },
+                "message_severity": {
+                    "type": "string"
+                },
+                "message_title": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_lib_middleware_gin.Meta": {
+            "type": "object",
+            "properties": {
+                "request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_lib_middleware_gin.Response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "$ref": "#/definitions/go-test_lib_middleware_gin.Error"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/go-test_lib_middleware_gin.Meta"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "go-test_src_v1_contract.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "allowed_ips": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "description": "Key is only returned when the key is created.",
+                    "type": "string"
+                },
+                "key_prefix": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.AcceptInvitationRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "password",
+                "token"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.AssignMatchOfficialsRequest": {
+            "type": "object",
+            "properties": {
+                "officials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.OfficialAssignmentInput"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.CardDetail": {
+            "type": "object",
+            "properties": {
+                "card_minute": {
+                    "type": "integer"
+                },
+                "card_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "player_name": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.CardInput": {
+            "type": "object",
+            "required": [
+                "card_minute",
+                "card_type",
+                "player_id"
+            ],
+            "properties": {
+                "card_minute": {
+                    "type": "integer",
+                    "maximum": 120,
+                    "minimum": 1
+                },
+                "card_type": {
+                    "type": "string",
+                    "enum": [
+                        "yellow",
+                        "red"
+                    ]
+                },
+                "player_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.ChangeUserRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "super_admin",
+                        "league_admin",
+                        "team_manager",
+                        "official",
+                        "viewer"
+                    ]
+                },
+                "team_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "expires_at",
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "allowed_ips": {
+                    "description": "AllowedIPs lists the IPs and CIDR ranges the key may be used from.\nEmpty allows any address.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expires_at": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreateInvitationRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "super_admin",
+                        "league_admin",
+                        "team_manager",
+                        "official",
+                        "viewer"
+                    ]
+                },
+                "team_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreateMatchRequest": {
+            "type": "object",
+            "required": [
+                "away_team_id",
+                "home_team_id"
+            ],
+            "properties": {
+                "away_team_id": {
+                    "type": "integer"
+                },
+                "home_team_id": {
+                    "type": "integer"
+                },
+                "kickoff_at": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "match_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "match_time": {
+                    "description": "HH:MM",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "e.g. Asia/Makassar",
+                    "type": "string"
+                },
+                "venue_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreateOfficialRequest": {
+            "type": "object",
+            "required": [
+                "home_city",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "home_city": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreatePlayerRequest": {
+            "type": "object",
+            "required": [
+                "height",
+                "jersey_number",
+                "name",
+                "position",
+                "team_id",
+                "weight"
+            ],
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "jersey_number": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string",
+                    "enum": [
+                        "penyerang",
+                        "gelandang",
+                        "bertahan",
+                        "penjaga_gawang"
+                    ]
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreateStaffRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "role",
+                "start_date",
+                "team_id"
+            ],
+            "properties": {
+                "end_date": {
+                    "description": "YYYY-MM-DD, empty while in post",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "head_coach",
+                        "assistant_coach",
+                        "goalkeeping_coach",
+                        "physio"
+                    ]
+                },
+                "start_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreateVenueBlockRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "reason",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "description": "YYYY-MM-DD, inclusive",
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "maintenance",
+                        "event",
+                        "shared_tenancy",
+                        "other"
+                    ]
+                },
+                "start_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.CreateVenueRequest": {
+            "type": "object",
+            "required": [
+                "city",
+                "name"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "city": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string"
+                },
+                "surface": {
+                    "type": "string",
+                    "enum": [
+                        "grass",
+                        "artificial",
+                        "hybrid"
+                    ]
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.FeedTokenResponse": {
+            "type": "object",
+            "properties": {
+                "all_matches_url": {
+                    "type": "string"
+                },
+                "team_feed_url": {
+                    "description": "replace {id} with the team ID",
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.GoalDetail": {
+            "type": "object",
+            "properties": {
+                "goal_minute": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "player_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.GoalInput": {
+            "type": "object",
+            "required": [
+                "goal_minute",
+                "player_id"
+            ],
+            "properties": {
+                "goal_minute": {
+                    "type": "integer",
+                    "maximum": 120,
+                    "minimum": 1
+                },
+                "player_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.HeadToHeadMatch": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "type": "integer"
+                },
+                "away_team_id": {
+                    "type": "integer"
+                },
+                "home_score": {
+                    "type": "integer"
+                },
+                "home_team_id": {
+                    "type": "integer"
+                },
+                "match_date": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "match_time": {
+                    "type": "string"
+                },
+                "result": {
+                    "description": "W, D or L from the requested team's point of view",
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.HeadToHeadResponse": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "type": "string"
+                },
+                "last_results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.HeadToHeadMatch"
+                    }
+                },
+                "meetings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.HeadToHeadMatch"
+                    }
+                },
+                "opponent": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.HeadToHeadSide"
+                },
+                "played": {
+                    "type": "integer"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.HeadToHeadSide"
+                },
+                "top_scorers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.HeadToHeadScorer"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.HeadToHeadScorer": {
+            "type": "object",
+            "properties": {
+                "goals": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "description": "nil for goals whose team is not known",
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.HeadToHeadSide": {
+            "type": "object",
+            "properties": {
+                "goals": {
+                    "type": "integer"
+                },
+                "record": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamRecord"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.HypotheticalResult": {
+            "type": "object",
+            "required": [
+                "match_id"
+            ],
+            "properties": {
+                "away_score": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "home_score": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "match_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.InvitationResponse": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "invited_by": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "team_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "token": {
+                    "description": "Token is only returned when the invitation is created.",
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.JWKSResponse": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.JWK"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchCoaches": {
+            "type": "object",
+            "properties": {
+                "away_team": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.StaffBrief"
+                    }
+                },
+                "home_team": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.StaffBrief"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchOfficialResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "official": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.OfficialBrief"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchOfficialsResponse": {
+            "type": "object",
+            "properties": {
+                "match_id": {
+                    "type": "integer"
+                },
+                "officials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.MatchOfficialResponse"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchPredictionGoals": {
+            "type": "object",
+            "properties": {
+                "away": {
+                    "type": "number"
+                },
+                "home": {
+                    "type": "number"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchPredictionOutcome": {
+            "type": "object",
+            "properties": {
+                "away_win": {
+                    "type": "number"
+                },
+                "draw": {
+                    "type": "number"
+                },
+                "home_win": {
+                    "type": "number"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchPredictionResponse": {
+            "type": "object",
+            "properties": {
+                "away_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "expected_goals": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.MatchPredictionGoals"
+                },
+                "home_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "likely_scores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.MatchPredictionScore"
+                    }
+                },
+                "match_date": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "match_time": {
+                    "type": "string"
+                },
+                "matches_analysed": {
+                    "type": "integer"
+                },
+                "probabilities": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.MatchPredictionOutcome"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchPredictionScore": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "type": "integer"
+                },
+                "home_score": {
+                    "type": "integer"
+                },
+                "probability": {
+                    "type": "number"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchReportHeadToHead": {
+            "type": "object",
+            "properties": {
+                "away_team_wins": {
+                    "type": "integer"
+                },
+                "draws": {
+                    "type": "integer"
+                },
+                "home_team_wins": {
+                    "type": "integer"
+                },
+                "played": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchReportResponse": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "type": "integer"
+                },
+                "away_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "away_team_total_wins": {
+                    "type": "integer"
+                },
+                "cards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.CardDetail"
+                    }
+                },
+                "coaches": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.MatchCoaches"
+                },
+                "display_timezone": {
+                    "type": "string"
+                },
+                "final_status": {
+                    "type": "string"
+                },
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.GoalDetail"
+                    }
+                },
+                "head_to_head": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.MatchReportHeadToHead"
+                },
+                "home_score": {
+                    "type": "integer"
+                },
+                "home_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "home_team_total_wins": {
+                    "type": "integer"
+                },
+                "kickoff_at": {
+                    "type": "string"
+                },
+                "match_date": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "match_time": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "top_scorer": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TopScorerInfo"
+                },
+                "venue": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.VenueBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.MatchResponse": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "type": "integer"
+                },
+                "away_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "cards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.CardDetail"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "display_timezone": {
+                    "type": "string"
+                },
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.GoalDetail"
+                    }
+                },
+                "home_score": {
+                    "type": "integer"
+                },
+                "home_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kickoff_at": {
+                    "type": "string"
+                },
+                "match_date": {
+                    "type": "string"
+                },
+                "match_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "venue": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.VenueBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.OfficialAssignmentInput": {
+            "type": "object",
+            "required": [
+                "official_id",
+                "role"
+            ],
+            "properties": {
+                "official_id": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "referee",
+                        "assistant_referee",
+                        "fourth_official",
+                        "var"
+                    ]
+                }
+            }
+        },
+        "go-test_src_v1_contract.OfficialBrief": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.OfficialReportAssignment": {
+            "type": "object",
+            "properties": {
+                "away_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "home_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "kickoff_at": {
+                    "type": "string"
+                },
+                "match_date": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "red_cards": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "yellow_cards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.OfficialReportResponse": {
+            "type": "object",
+            "properties": {
+                "assignments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.OfficialReportAssignment"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "official": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.OfficialBrief"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totals": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.OfficialReportTotals"
+                }
+            }
+        },
+        "go-test_src_v1_contract.OfficialReportTotals": {
+            "type": "object",
+            "properties": {
+                "assistant_referee": {
+                    "type": "integer"
+                },
+                "fourth_official": {
+                    "type": "integer"
+                },
+                "matches": {
+                    "type": "integer"
+                },
+                "red_cards": {
+                    "type": "integer"
+                },
+                "referee": {
+                    "type": "integer"
+                },
+                "var": {
+                    "type": "integer"
+                },
+                "yellow_cards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.OfficialResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "home_city": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.PlayerLeaderboardEntry": {
+            "type": "object",
+            "properties": {
+                "goals": {
+                    "type": "integer"
+                },
+                "matches_with_goal": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.PlayerLeaderboardResponse": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.PlayerLeaderboardEntry"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.PlayerResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "jersey_number": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "go-test_src_v1_contract.PlayerSeasonStats": {
+            "type": "object",
+            "properties": {
+                "first_goal_date": {
+                    "type": "string"
+                },
+                "goals": {
+                    "type": "integer"
+                },
+                "last_goal_date": {
+                    "type": "string"
+                },
+                "matches_with_goal": {
+                    "type": "integer"
+                },
+                "season": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.PlayerStatSummary": {
+            "type": "object",
+            "properties": {
+                "first_goal_date": {
+                    "type": "string"
+                },
+                "goals": {
+                    "type": "integer"
+                },
+                "last_goal_date": {
+                    "type": "string"
+                },
+                "matches_with_goal": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.PlayerStatsResponse": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.PlayerSeasonStats"
+                    }
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.PlayerTeamStats"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totals": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.PlayerStatSummary"
+                }
+            }
+        },
+        "go-test_src_v1_contract.PlayerTeamStats": {
+            "type": "object",
+            "properties": {
+                "first_goal_date": {
+                    "type": "string"
+                },
+                "goals": {
+                    "type": "integer"
+                },
+                "last_goal_date": {
+                    "type": "string"
+                },
+                "matches_with_goal": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.RankingEntry": {
+            "type": "object",
+            "properties": {
+                "last_change": {
+                    "type": "number"
+                },
+                "last_match_date": {
+                    "type": "string"
+                },
+                "matches": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.RatingHistoryEntry": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "type": "number"
+                },
+                "match_date": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "opponent": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "rating_after": {
+                    "type": "number"
+                },
+                "rating_before": {
+                    "type": "number"
+                }
+            }
+        },
+        "go-test_src_v1_contract.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                }
+            }
+        },
+        "go-test_src_v1_contract.RegisterResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.ResendVerificationRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.SeasonSimulationRequest": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "iterations": {
+                    "type": "integer",
+                    "maximum": 20000,
+                    "minimum": 1
+                },
+                "relegation_spots": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "to": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.SeasonSimulationResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "remaining_matches": {
+                    "type": "integer"
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.SeasonSimulationTeam"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.SeasonSimulationTeam": {
+            "type": "object",
+            "properties": {
+                "current_points": {
+                    "type": "integer"
+                },
+                "expected_points": {
+                    "type": "number"
+                },
+                "position_probabilities": {
+                    "description": "PositionProbabilities[i] is the probability of finishing in position i+1.",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "relegation_probability": {
+                    "type": "number"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "title_probability": {
+                    "type": "number"
+                }
+            }
+        },
+        "go-test_src_v1_contract.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "description": "Current is set for the session of the token making the request.",
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.StaffBrief": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.StaffResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.StandingEntry": {
+            "type": "object",
+            "properties": {
+                "draws": {
+                    "type": "integer"
+                },
+                "goal_difference": {
+                    "type": "integer"
+                },
+                "goals_against": {
+                    "type": "integer"
+                },
+                "goals_for": {
+                    "type": "integer"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "played": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.SubmitResultRequest": {
             "type": "object",
             "properties": {
-                "request_id": {
+                "away_score": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "cards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.CardInput"
+                    }
+                },
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.GoalInput"
+                    }
+                },
+                "home_score": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "go-test_src_v1_contract.TeamBrief": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "logo": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "go-test_lib_middleware_gin.Response": {
+        "go-test_src_v1_contract.TeamForm": {
             "type": "object",
             "properties": {
-                "data": {},
-                "error": {
-                    "$ref": "#/definitions/go-test_lib_middleware_gin.Error"
+                "current": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreaks"
                 },
-                "metadata": {
-                    "$ref": "#/definitions/go-test_lib_middleware_gin.Meta"
+                "form": {
+                    "description": "oldest first, most recent last",
+                    "type": "string"
                 },
-                "success": {
-                    "type": "boolean"
+                "played": {
+                    "type": "integer"
+                },
+                "record": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreaks"
                 }
             }
         },
-        "go-test_src_v1_contract.AuthResponse": {
+        "go-test_src_v1_contract.TeamFormResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "as_of": {
+                    "type": "string"
+                },
+                "current": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreaks"
+                },
+                "form": {
+                    "description": "oldest first, most recent last",
                     "type": "string"
+                },
+                "played": {
+                    "type": "integer"
+                },
+                "record": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreaks"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
                 }
             }
         },
-        "go-test_src_v1_contract.CreateMatchRequest": {
+        "go-test_src_v1_contract.TeamRatingHistoryResponse": {
             "type": "object",
-            "required": [
-                "away_team_id",
-                "home_team_id",
-                "match_date",
-                "match_time"
-            ],
             "properties": {
-                "away_team_id": {
+                "current_rating": {
+                    "type": "number"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.RatingHistoryEntry"
+                    }
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.TeamRecord": {
+            "type": "object",
+            "properties": {
+                "draws": {
                     "type": "integer"
                 },
-                "home_team_id": {
+                "losses": {
                     "type": "integer"
                 },
-                "match_date": {
-                    "description": "YYYY-MM-DD",
+                "played": {
+                    "type": "integer"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.TeamResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "match_time": {
-                    "description": "HH:MM",
+                "city": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "form": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamForm"
+                },
+                "home_venue_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "logo": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "staff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.StaffResponse"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "year_founded": {
+                    "type": "integer"
                 }
             }
         },
-        "go-test_src_v1_contract.CreatePlayerRequest": {
+        "go-test_src_v1_contract.TeamStatMatch": {
             "type": "object",
-            "required": [
-                "height",
-                "jersey_number",
-                "name",
-                "position",
-                "team_id",
-                "weight"
-            ],
             "properties": {
-                "height": {
+                "goals_against": {
+                    "type": "integer"
+                },
+                "goals_for": {
+                    "type": "integer"
+                },
+                "match_date": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "opponent": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.TeamStatsResponse": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "type": "string"
+                },
+                "average_goal_minute": {
                     "type": "number"
                 },
-                "jersey_number": {
-                    "type": "integer",
-                    "maximum": 99,
-                    "minimum": 1
+                "away": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamRecord"
                 },
-                "name": {
+                "biggest_loss": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStatMatch"
+                },
+                "biggest_win": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStatMatch"
+                },
+                "clean_sheets": {
+                    "type": "integer"
+                },
+                "failed_to_score": {
+                    "type": "integer"
+                },
+                "from": {
                     "type": "string"
                 },
-                "position": {
-                    "type": "string",
-                    "enum": [
-                        "penyerang",
-                        "gelandang",
-                        "bertahan",
-                        "penjaga_gawang"
-                    ]
+                "goal_difference": {
+                    "type": "integer"
                 },
-                "team_id": {
+                "goals_against": {
                     "type": "integer"
                 },
-                "weight": {
-                    "type": "number"
+                "goals_for": {
+                    "type": "integer"
+                },
+                "home": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamRecord"
+                },
+                "overall": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamRecord"
+                },
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "to": {
+                    "type": "string"
                 }
             }
         },
-        "go-test_src_v1_contract.GoalDetail": {
+        "go-test_src_v1_contract.TeamStreak": {
             "type": "object",
             "properties": {
-                "goal_minute": {
+                "count": {
                     "type": "integer"
                 },
-                "id": {
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.TeamStreaks": {
+            "type": "object",
+            "properties": {
+                "losing": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreak"
+                },
+                "scoreless": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreak"
+                },
+                "unbeaten": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreak"
+                },
+                "winning": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamStreak"
+                }
+            }
+        },
+        "go-test_src_v1_contract.TopScorerInfo": {
+            "type": "object",
+            "properties": {
+                "goals": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "player_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.UpdateMatchRequest": {
+            "type": "object",
+            "properties": {
+                "away_team_id": {
+                    "type": "integer"
                 },
-                "player_name": {
+                "home_team_id": {
+                    "type": "integer"
+                },
+                "kickoff_at": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "match_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "match_time": {
+                    "description": "HH:MM",
+                    "type": "string"
+                },
+                "timezone": {
                     "type": "string"
+                },
+                "venue_id": {
+                    "type": "integer"
                 }
             }
         },
-        "go-test_src_v1_contract.GoalInput": {
+        "go-test_src_v1_contract.UpdateOfficialRequest": {
             "type": "object",
-            "required": [
-                "goal_minute",
-                "player_id"
-            ],
             "properties": {
-                "goal_minute": {
+                "email": {
+                    "type": "string"
+                },
+                "home_city": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "go-test_src_v1_contract.UpdatePlayerRequest": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "jersey_number": {
                     "type": "integer",
-                    "maximum": 120,
+                    "maximum": 99,
                     "minimum": 1
                 },
-                "player_id": {
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string",
+                    "enum": [
+                        "penyerang",
+                        "gelandang",
+                        "bertahan",
+                        "penjaga_gawang"
+                    ]
+                },
+                "team_id": {
                     "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
                 }
             }
         },
-        "go-test_src_v1_contract.LoginRequest": {
+        "go-test_src_v1_contract.UpdateStaffRequest": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "clear_end_date": {
+                    "description": "ClearEndDate removes the end date, marking the tenure as ongoing again.",
+                    "type": "boolean"
+                },
+                "end_date": {
                     "type": "string"
                 },
-                "password": {
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "head_coach",
+                        "assistant_coach",
+                        "goalkeeping_coach",
+                        "physio"
+                    ]
+                },
+                "start_date": {
                     "type": "string"
+                },
+                "team_id": {
+                    "type": "integer"
                 }
             }
         },
-        "go-test_src_v1_contract.MatchReportResponse": {
+        "go-test_src_v1_contract.UpdateVenueRequest": {
             "type": "object",
             "properties": {
-                "away_score": {
-                    "type": "integer"
-                },
-                "away_team": {
-                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
-                },
-                "away_team_total_wins": {
-                    "type": "integer"
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "final_status": {
+                "city": {
                     "type": "string"
                 },
-                "goals": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/go-test_src_v1_contract.GoalDetail"
-                    }
-                },
-                "home_score": {
-                    "type": "integer"
-                },
-                "home_team": {
-                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
                 },
-                "home_team_total_wins": {
-                    "type": "integer"
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
                 },
-                "match_date": {
+                "name": {
                     "type": "string"
                 },
-                "match_id": {
-                    "type": "integer"
+                "surface": {
+                    "type": "string",
+                    "enum": [
+                        "grass",
+                        "artificial",
+                        "hybrid"
+                    ]
                 },
-                "match_time": {
+                "timezone": {
                     "type": "string"
-                },
-                "top_scorer": {
-                    "$ref": "#/definitions/go-test_src_v1_contract.TopScorerInfo"
                 }
             }
         },
-        "go-test_src_v1_contract.MatchResponse": {
+        "go-test_src_v1_contract.UserResponse": {
             "type": "object",
             "properties": {
-                "away_score": {
-                    "type": "integer"
-                },
-                "away_team": {
-                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
-                },
                 "created_at": {
                     "type": "string"
                 },
-                "goals": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/go-test_src_v1_contract.GoalDetail"
-                    }
+                "disabled": {
+                    "type": "boolean"
                 },
-                "home_score": {
-                    "type": "integer"
+                "disabled_at": {
+                    "type": "string"
                 },
-                "home_team": {
-                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                "email": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "match_date": {
+                "name": {
                     "type": "string"
                 },
-                "match_time": {
+                "role": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
+                "team_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "go-test_src_v1_contract.PlayerResponse": {
+        "go-test_src_v1_contract.VenueBlockResponse": {
             "type": "object",
             "properties": {
+                "conflicting_match_ids": {
+                    "description": "ConflictingMatchIDs lists the matches already scheduled at the venue\nduring a newly created block, which need to be moved.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "height": {
-                    "type": "number"
+                "end_date": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "jersey_number": {
-                    "type": "integer"
-                },
-                "name": {
+                "note": {
                     "type": "string"
                 },
-                "position": {
+                "reason": {
                     "type": "string"
                 },
-                "team_id": {
-                    "type": "integer"
-                },
-                "updated_at": {
+                "start_date": {
                     "type": "string"
                 },
-                "weight": {
-                    "type": "number"
+                "venue_id": {
+                    "type": "integer"
                 }
             }
         },
-        "go-test_src_v1_contract.RegisterRequest": {
+        "go-test_src_v1_contract.VenueBrief": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "city": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
-                },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
                 }
             }
         },
-        "go-test_src_v1_contract.SubmitResultRequest": {
+        "go-test_src_v1_contract.VenueCalendarEntry": {
             "type": "object",
             "properties": {
-                "away_score": {
-                    "type": "integer",
-                    "minimum": 0
+                "block": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.VenueBlockResponse"
                 },
-                "goals": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/go-test_src_v1_contract.GoalInput"
-                    }
+                "end_date": {
+                    "type": "string"
                 },
-                "home_score": {
-                    "type": "integer",
-                    "minimum": 0
+                "match": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.VenueCalendarMatch"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "match | blocked",
+                    "type": "string"
                 }
             }
         },
-        "go-test_src_v1_contract.TeamBrief": {
+        "go-test_src_v1_contract.VenueCalendarMatch": {
             "type": "object",
             "properties": {
+                "away_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "home_team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "logo": {
+                "kickoff_at": {
                     "type": "string"
                 },
-                "name": {
+                "status": {
+                    "type": "string"
+                },
+                "timezone": {
                     "type": "string"
                 }
             }
         },
-        "go-test_src_v1_contract.TeamResponse": {
+        "go-test_src_v1_contract.VenueCalendarResponse": {
             "type": "object",
             "properties": {
-                "address": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.VenueCalendarEntry"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
                     "type": "string"
                 },
+                "venue": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.VenueBrief"
+                }
+            }
+        },
+        "go-test_src_v1_contract.VenueResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
                 "city": {
                     "type": "string"
                 },
@@ -1501,79 +6678,108 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
-                "logo": {
-                    "type": "string"
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
                 },
                 "name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "surface": {
                     "type": "string"
                 },
-                "year_founded": {
-                    "type": "integer"
+                "timezone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "go-test_src_v1_contract.TopScorerInfo": {
+        "go-test_src_v1_contract.VerifyEmailRequest": {
             "type": "object",
+            "required": [
+                "token"
+            ],
             "properties": {
-                "goals": {
-                    "type": "integer"
-                },
-                "name": {
+                "token": {
                     "type": "string"
-                },
-                "player_id": {
-                    "type": "integer"
                 }
             }
         },
-        "go-test_src_v1_contract.UpdateMatchRequest": {
+        "go-test_src_v1_contract.WhatIfStandingEntry": {
             "type": "object",
             "properties": {
-                "away_team_id": {
+                "current_position": {
                     "type": "integer"
                 },
-                "home_team_id": {
+                "draws": {
                     "type": "integer"
                 },
-                "match_date": {
-                    "type": "string"
+                "goal_difference": {
+                    "type": "integer"
                 },
-                "match_time": {
-                    "type": "string"
-                }
-            }
-        },
-        "go-test_src_v1_contract.UpdatePlayerRequest": {
-            "type": "object",
-            "properties": {
-                "height": {
-                    "type": "number"
+                "goals_against": {
+                    "type": "integer"
                 },
-                "jersey_number": {
-                    "type": "integer",
-                    "maximum": 99,
-                    "minimum": 1
+                "goals_for": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "losses": {
+                    "type": "integer"
+                },
+                "played": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "points_gained": {
+                    "type": "integer"
                 },
                 "position": {
-                    "type": "string",
-                    "enum": [
-                        "penyerang",
-                        "gelandang",
-                        "bertahan",
-                        "penjaga_gawang"
-                    ]
+                    "type": "integer"
                 },
-                "team_id": {
+                "team": {
+                    "$ref": "#/definitions/go-test_src_v1_contract.TeamBrief"
+                },
+                "wins": {
                     "type": "integer"
+                }
+            }
+        },
+        "go-test_src_v1_contract.WhatIfStandingsRequest": {
+            "type": "object",
+            "required": [
+                "results"
+            ],
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.HypotheticalResult"
+                    }
+                }
+            }
+        },
+        "go-test_src_v1_contract.WhatIfStandingsResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.HypotheticalResult"
+                    }
                 },
-                "weight": {
-                    "type": "number"
+                "table": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/go-test_src_v1_contract.WhatIfStandingEntry"
+                    }
                 }
             }
         }